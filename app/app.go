@@ -12,6 +12,11 @@ import (
 
 	customkeeper "github.com/KiraCore/sekai/x/staking/keeper"
 
+	"github.com/KiraCore/sekai/x/dex"
+	dexclient "github.com/KiraCore/sekai/x/dex/client"
+	dexkeeper "github.com/KiraCore/sekai/x/dex/keeper"
+	dextypes "github.com/KiraCore/sekai/x/dex/types"
+
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 
@@ -20,6 +25,7 @@ import (
 	cumstomtypes "github.com/KiraCore/sekai/x/staking/types"
 
 	customstaking "github.com/KiraCore/sekai/x/staking"
+	customstakingclient "github.com/KiraCore/sekai/x/staking/client"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 
@@ -34,6 +40,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/std"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -107,6 +114,8 @@ var (
 		//distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			dexclient.DelistOrderBookProposalHandler, dexclient.ReassignOrderBookProposalHandler,
+			customstakingclient.SetValidatorPowerProposalHandler, customstakingclient.SetValidatorWhitelistProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -116,6 +125,7 @@ var (
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
 		customstaking.AppModuleBasic{},
+		dex.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -127,6 +137,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		dextypes.ModuleName:            nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -166,6 +177,7 @@ type SekaiApp struct {
 	transferKeeper   ibctransferkeeper.Keeper
 
 	customStakingKeeper customkeeper.Keeper
+	dexKeeper           dexkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	scopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -202,7 +214,7 @@ func NewInitApp(
 		distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
-		cumstomtypes.ModuleName,
+		cumstomtypes.ModuleName, dextypes.StoreKey,
 	)
 	tKeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -250,12 +262,27 @@ func NewInitApp(
 	)
 	app.upgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath)
 
+	app.dexKeeper = dexkeeper.NewKeeper(
+		keys[dextypes.StoreKey], appCodec, app.GetSubspace(dextypes.ModuleName), app.bankKeeper, authtypes.FeeCollectorName,
+	)
+
+	app.customStakingKeeper = customkeeper.NewKeeper(keys[cumstomtypes.ModuleName], appCodec, app.GetSubspace(cumstomtypes.ModuleName))
+	protoStoreUpgradeHandler := customstaking.NewProtoStoreUpgradeHandler(app.customStakingKeeper, cdc)
+	app.upgradeKeeper.SetUpgradeHandler(customstaking.ProtoStoreUpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan) {
+		protoStoreUpgradeHandler(ctx, plan)
+
+		// The dex store is new to the upgrading chains, it starts from the default genesis.
+		dex.InitGenesis(ctx, app.dexKeeper, *dextypes.DefaultGenesis())
+	})
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(dextypes.RouterKey, dex.NewOrderBookProposalHandler(app.dexKeeper)).
+		AddRoute(cumstomtypes.RouterKey, customstaking.NewValidatorProposalHandler(app.customStakingKeeper))
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.accountKeeper, app.bankKeeper,
 		&stakingKeeper, govRouter,
@@ -267,8 +294,6 @@ func NewInitApp(
 		stakingtypes.NewMultiStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()),
 	)
 
-	app.customStakingKeeper = customkeeper.NewKeeper(keys[cumstomtypes.ModuleName], cdc)
-
 	app.ibcKeeper = ibckeeper.NewKeeper(
 		appCodec, keys[ibchost.StoreKey], app.stakingKeeper, scopedIBCKeeper,
 	)
@@ -290,7 +315,7 @@ func NewInitApp(
 
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
-		appCodec, keys[evidencetypes.StoreKey], &app.stakingKeeper, app.slashingKeeper,
+		appCodec, keys[evidencetypes.StoreKey], app.customStakingKeeper, app.customStakingKeeper,
 	)
 	evidenceRouter := evidencetypes.NewRouter().
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.HandlerClientMisbehaviour(app.ibcKeeper.ClientKeeper))
@@ -317,6 +342,7 @@ func NewInitApp(
 		params.NewAppModule(app.paramsKeeper),
 		transferModule,
 		customstaking.NewAppModule(app.customStakingKeeper),
+		dex.NewAppModule(app.dexKeeper),
 	)
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
@@ -324,9 +350,9 @@ func NewInitApp(
 
 	app.mm.SetOrderBeginBlockers(
 		upgradetypes.ModuleName, /*distrtypes.ModuleName, slashingtypes.ModuleName,*/
-		evidencetypes.ModuleName /*stakingtypes.ModuleName,*/, ibchost.ModuleName,
+		evidencetypes.ModuleName /*stakingtypes.ModuleName,*/, ibchost.ModuleName, dextypes.ModuleName, cumstomtypes.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, cumstomtypes.ModuleName /*stakingtypes.ModuleName*/)
+	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, dextypes.ModuleName, cumstomtypes.ModuleName /*stakingtypes.ModuleName*/)
 
 	// NOTE: The genutils moodule must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -337,7 +363,7 @@ func NewInitApp(
 		capabilitytypes.ModuleName, authtypes.ModuleName /*distrtypes.ModuleName */ /*stakingtypes.ModuleName,*/, banktypes.ModuleName,
 		/*slashingtypes.ModuleName,*/ govtypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
-		cumstomtypes.ModuleName,
+		cumstomtypes.ModuleName, dextypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -370,6 +396,18 @@ func NewInitApp(
 	app.MountTransientStores(tKeys)
 	app.MountMemoryStores(memKeys)
 
+	// Stores mounted since the baseline, like the dex store, are missing from
+	// the last commit of the chains running the upgrade. The multistore of
+	// this SDK version creates them empty, so the upgrade has no store to
+	// rename or delete.
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		tmos.Exit(err.Error())
+	}
+	if upgradeInfo.Name == customstaking.ProtoStoreUpgradeName && !app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{}))
+	}
+
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
@@ -543,6 +581,8 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
+	paramsKeeper.Subspace(dextypes.ModuleName)
+	paramsKeeper.Subspace(cumstomtypes.ModuleName)
 
 	return paramsKeeper
 }
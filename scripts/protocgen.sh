@@ -5,12 +5,17 @@ set -eo pipefail
 proto_dirs=$(find ./proto -path -prune -o -name '*.proto' -print0 | xargs -0 -n1 dirname | sort | uniq)
 echo $proto_dirs
 
+# files are generated one at a time, so that a directory can hold protos
+# targeting different go packages (e.g. kira/dex feeds both types and x/dex/types)
 for dir in $proto_dirs; do
-  protoc -I "$dir" -I "third_party/proto" --gocosmos_out=plugins=interfacetype+grpc,\
+  for file in $(find "${dir}" -maxdepth 1 -name '*.proto'); do
+    protoc -I "$dir" -I "third_party/proto" --gocosmos_out=plugins=interfacetype+grpc,\
 Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:. \
-  $(find "${dir}" -maxdepth 1 -name '*.proto')
+    "$file"
+  done
 done
 
 # move proto files to the right places
 cp -r github.com/cosmos/cosmos-sdk/* ./
-rm -rf github.com
\ No newline at end of file
+cp -r github.com/KiraCore/sekai/* ./
+rm -rf github.com
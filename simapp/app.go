@@ -5,9 +5,15 @@ import (
 	"os"
 
 	customstaking "github.com/KiraCore/sekai/x/staking"
+	customstakingclient "github.com/KiraCore/sekai/x/staking/client"
 	types2 "github.com/KiraCore/sekai/x/staking/types"
 
 	"github.com/KiraCore/sekai/x/staking/keeper"
+
+	"github.com/KiraCore/sekai/x/dex"
+	dexclient "github.com/KiraCore/sekai/x/dex/client"
+	dexkeeper "github.com/KiraCore/sekai/x/dex/keeper"
+	dextypes "github.com/KiraCore/sekai/x/dex/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
@@ -22,6 +28,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/std"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -100,6 +107,8 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			dexclient.DelistOrderBookProposalHandler, dexclient.ReassignOrderBookProposalHandler,
+			customstakingclient.SetValidatorPowerProposalHandler, customstakingclient.SetValidatorWhitelistProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -110,6 +119,7 @@ var (
 		transfer.AppModuleBasic{},
 
 		customstaking.AppModuleBasic{},
+		dex.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -121,6 +131,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		dextypes.ModuleName:            nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -164,6 +175,7 @@ type SimApp struct {
 	TransferKeeper   ibctransferkeeper.Keeper
 
 	CustomStakingKeeper keeper.Keeper
+	DexKeeper           dexkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -197,7 +209,7 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
-		types2.ModuleName,
+		types2.ModuleName, dextypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -249,12 +261,27 @@ func NewSimApp(
 	)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath)
 
+	app.DexKeeper = dexkeeper.NewKeeper(
+		keys[dextypes.StoreKey], appCodec, app.GetSubspace(dextypes.ModuleName), app.BankKeeper, authtypes.FeeCollectorName,
+	)
+
+	app.CustomStakingKeeper = keeper.NewKeeper(keys[types2.ModuleName], appCodec, app.GetSubspace(types2.ModuleName))
+	protoStoreUpgradeHandler := customstaking.NewProtoStoreUpgradeHandler(app.CustomStakingKeeper, cdc)
+	app.UpgradeKeeper.SetUpgradeHandler(customstaking.ProtoStoreUpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan) {
+		protoStoreUpgradeHandler(ctx, plan)
+
+		// The dex store is new to the upgrading chains, it starts from the default genesis.
+		dex.InitGenesis(ctx, app.DexKeeper, *dextypes.DefaultGenesis())
+	})
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(dextypes.RouterKey, dex.NewOrderBookProposalHandler(app.DexKeeper)).
+		AddRoute(types2.RouterKey, customstaking.NewValidatorProposalHandler(app.CustomStakingKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
@@ -266,8 +293,6 @@ func NewSimApp(
 		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
 
-	app.CustomStakingKeeper = keeper.NewKeeper(keys[types2.ModuleName], cdc)
-
 	// Create IBC Keeper
 	// TODO: remove amino codec dependency once Tendermint version is upgraded with
 	// protobuf changes
@@ -319,6 +344,8 @@ func NewSimApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
+		dex.NewAppModule(app.DexKeeper),
+		customstaking.NewAppModule(app.CustomStakingKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -340,6 +367,7 @@ func NewSimApp(
 		capabilitytypes.ModuleName, authtypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName, banktypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
+		dextypes.ModuleName, types2.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -375,6 +403,18 @@ func NewSimApp(
 	app.MountTransientStores(tkeys)
 	app.MountMemoryStores(memKeys)
 
+	// Stores mounted since the baseline, like the dex store, are missing from
+	// the last commit of the chains running the upgrade. The multistore of
+	// this SDK version creates them empty, so the upgrade has no store to
+	// rename or delete.
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		tmos.Exit(err.Error())
+	}
+	if upgradeInfo.Name == customstaking.ProtoStoreUpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{}))
+	}
+
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
@@ -548,6 +588,8 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
+	paramsKeeper.Subspace(dextypes.ModuleName)
+	paramsKeeper.Subspace(types2.ModuleName)
 
 	return paramsKeeper
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/tendermint/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
+	"github.com/KiraCore/sekai/x/staking/client/cli"
 	customtypes "github.com/KiraCore/sekai/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
@@ -156,15 +157,16 @@ func initGenFiles(cfg Config, vals []*Validator, genAccounts []authtypes.Genesis
 	bankGenState.Balances = genBalances
 	cfg.GenesisState[banktypes.ModuleName] = cfg.Codec.MustMarshalJSON(&bankGenState)
 
-	var customStakingGenState customtypes.GenesisState
 	for _, val := range vals {
 		validator, err := customtypes.NewValidator(val.Moniker, "the Website", "The social", "The Identity", types2.NewDec(1), val.ValAddress, val.PubKey)
 		if err != nil {
 			return errors.Wrap(err, "error creating validator")
 		}
-		customStakingGenState.Validators = append(customStakingGenState.Validators, validator)
+
+		if err := cli.AddGenesisValidator(cfg.Codec, cfg.GenesisState, validator); err != nil {
+			return err
+		}
 	}
-	cfg.GenesisState[customtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&customStakingGenState)
 
 	appGenStateJSON, err := json.MarshalIndent(cfg.GenesisState, "", "  ")
 	if err != nil {
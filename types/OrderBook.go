@@ -3,19 +3,8 @@ package types
 import (
 	"fmt"
 	"strings"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-type OrderBook struct {
-	ID       string         `json:"id"`
-	Index    uint32         `json:"index"`
-	Base     string         `json:"base"`
-	Quote    string         `json:"quote"`
-	Mnemonic string         `json:"mnemonic"`
-	Curator  sdk.AccAddress `json:"curator"`
-}
-
 func NewOrderBook() OrderBook {
 	return OrderBook{
 		Index:    0,
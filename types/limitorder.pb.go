@@ -0,0 +1,653 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: limitorder.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type LimitOrder struct {
+	ID          string                                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Index       uint32                                        `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	OrderBookID string                                        `protobuf:"bytes,3,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	OrderType   uint8                                         `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,casttype=uint8" json:"order_type,omitempty"`
+	Amount      int64                                         `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	LimitPrice  int64                                         `protobuf:"varint,6,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	ExpiryTime  int64                                         `protobuf:"varint,7,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	IsCancelled bool                                          `protobuf:"varint,8,opt,name=is_cancelled,json=isCancelled,proto3" json:"is_cancelled,omitempty"`
+	Curator     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,9,opt,name=curator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"curator,omitempty" yaml:"curator"`
+}
+
+func (m *LimitOrder) Reset()      { *m = LimitOrder{} }
+func (*LimitOrder) ProtoMessage() {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5f0c35b41c22182, []int{0}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetOrderBookID() string {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetOrderType() uint8 {
+	if m != nil {
+		return m.OrderType
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetLimitPrice() int64 {
+	if m != nil {
+		return m.LimitPrice
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetExpiryTime() int64 {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetIsCancelled() bool {
+	if m != nil {
+		return m.IsCancelled
+	}
+	return false
+}
+
+func (m *LimitOrder) GetCurator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Curator
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LimitOrder)(nil), "kira.dex.LimitOrder")
+}
+
+func init() { proto.RegisterFile("limitorder.proto", fileDescriptor_a5f0c35b41c22182) }
+
+var fileDescriptor_a5f0c35b41c22182 = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x31, 0x6f, 0xdb, 0x30,
+	0x10, 0x85, 0x4d, 0x25, 0x76, 0x6c, 0x3a, 0x69, 0x0b, 0x22, 0x08, 0x88, 0x0e, 0xa2, 0xea, 0x49,
+	0x4b, 0xec, 0x21, 0x4b, 0x9b, 0x2d, 0x72, 0x96, 0xa0, 0x05, 0x5a, 0x08, 0x99, 0x0a, 0x14, 0x82,
+	0x4c, 0x12, 0xee, 0x41, 0x96, 0x4f, 0x20, 0x69, 0xc0, 0xfe, 0x17, 0x1d, 0x3b, 0xf6, 0xe7, 0x74,
+	0xcc, 0xd8, 0x49, 0x28, 0xe4, 0x7f, 0xd0, 0x31, 0x5d, 0x0a, 0x51, 0x4e, 0x91, 0x89, 0x7c, 0xef,
+	0xdd, 0xfb, 0x88, 0x03, 0xe9, 0xab, 0x15, 0x94, 0xe0, 0xd0, 0x28, 0x6d, 0xa6, 0x95, 0x41, 0x87,
+	0x6c, 0x58, 0x80, 0xc9, 0xa7, 0x4a, 0x6f, 0x5f, 0x9f, 0x2f, 0x71, 0x89, 0xde, 0x9c, 0xb5, 0xb7,
+	0x2e, 0x9f, 0xfc, 0x0d, 0x28, 0xfd, 0xd0, 0x96, 0x3e, 0xb6, 0x25, 0x76, 0x41, 0x03, 0x50, 0x9c,
+	0x44, 0x24, 0x1e, 0x25, 0x83, 0xa6, 0x16, 0xc1, 0xdd, 0x6d, 0x1a, 0x80, 0x62, 0xe7, 0xb4, 0x0f,
+	0x6b, 0xa5, 0xb7, 0x3c, 0x88, 0x48, 0x7c, 0x96, 0x76, 0x82, 0x5d, 0xd1, 0x33, 0xff, 0x56, 0xb6,
+	0x40, 0x2c, 0x32, 0x50, 0xfc, 0xc8, 0x17, 0x5f, 0x36, 0xb5, 0x18, 0x7b, 0x5e, 0x82, 0x58, 0xdc,
+	0xdd, 0xa6, 0x63, 0xfc, 0x2f, 0x14, 0x8b, 0x29, 0xed, 0x4a, 0x6e, 0x57, 0x69, 0x7e, 0xdc, 0xf2,
+	0x92, 0xd1, 0x63, 0x2d, 0xfa, 0x1b, 0x58, 0xbb, 0xb7, 0xe9, 0xc8, 0x87, 0xf7, 0xbb, 0x4a, 0xb3,
+	0x0b, 0x3a, 0xc8, 0x4b, 0xdc, 0xac, 0x1d, 0xef, 0x47, 0x24, 0x3e, 0x4a, 0x0f, 0x8a, 0x09, 0x3a,
+	0xf6, 0x7b, 0x66, 0x95, 0x01, 0xa9, 0xf9, 0xc0, 0x87, 0xd4, 0x5b, 0x9f, 0x5a, 0xa7, 0x1d, 0xd0,
+	0xdb, 0x0a, 0xcc, 0x2e, 0x73, 0x50, 0x6a, 0x7e, 0xd2, 0x0d, 0x74, 0xd6, 0x3d, 0x94, 0x9a, 0xbd,
+	0xa1, 0xa7, 0x60, 0x33, 0x99, 0xaf, 0xa5, 0x5e, 0xad, 0xb4, 0xe2, 0xc3, 0x88, 0xc4, 0xc3, 0x74,
+	0x0c, 0x76, 0xfe, 0x64, 0xb1, 0x2f, 0xf4, 0x44, 0x6e, 0x4c, 0xee, 0xd0, 0xf0, 0x51, 0x44, 0xe2,
+	0xd3, 0x64, 0xfe, 0xa7, 0x16, 0x2f, 0x76, 0x79, 0xb9, 0xba, 0x9e, 0x1c, 0x82, 0xc9, 0x63, 0x2d,
+	0x2e, 0x97, 0xe0, 0xbe, 0x6e, 0x16, 0x53, 0x89, 0xe5, 0x4c, 0xa2, 0x2d, 0xd1, 0x1e, 0x8e, 0x4b,
+	0xab, 0x8a, 0x59, 0xbb, 0xa2, 0x9d, 0xde, 0x48, 0x79, 0xa3, 0x94, 0xd1, 0xd6, 0xa6, 0x4f, 0xcc,
+	0xeb, 0xe3, 0xef, 0x3f, 0x44, 0x2f, 0x79, 0xf7, 0xb3, 0x09, 0xc9, 0x43, 0x13, 0x92, 0xdf, 0x4d,
+	0x48, 0xbe, 0xed, 0xc3, 0xde, 0xc3, 0x3e, 0xec, 0xfd, 0xda, 0x87, 0xbd, 0xcf, 0xe2, 0x19, 0xf7,
+	0x3d, 0x98, 0x7c, 0x8e, 0x46, 0xcf, 0xac, 0x2e, 0x72, 0xe8, 0xa0, 0x8b, 0x81, 0xff, 0xbf, 0xab,
+	0x7f, 0x03, 0x00, 0x56, 0xa8, 0x79, 0x91, 0xf3, 0x01, 0x00, 0x00,
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintLimitorder(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.IsCancelled {
+		i--
+		if m.IsCancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpiryTime != 0 {
+		i = encodeVarintLimitorder(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LimitPrice != 0 {
+		i = encodeVarintLimitorder(dAtA, i, uint64(m.LimitPrice))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Amount != 0 {
+		i = encodeVarintLimitorder(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintLimitorder(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintLimitorder(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintLimitorder(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintLimitorder(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLimitorder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLimitorder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovLimitorder(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovLimitorder(uint64(m.Index))
+	}
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovLimitorder(uint64(l))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovLimitorder(uint64(m.OrderType))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovLimitorder(uint64(m.Amount))
+	}
+	if m.LimitPrice != 0 {
+		n += 1 + sovLimitorder(uint64(m.LimitPrice))
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovLimitorder(uint64(m.ExpiryTime))
+	}
+	if m.IsCancelled {
+		n += 2
+	}
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovLimitorder(uint64(l))
+	}
+	return n
+}
+
+func sovLimitorder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLimitorder(x uint64) (n int) {
+	return sovLimitorder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLimitorder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitorder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitorder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitorder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitorder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitorder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitorder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitorder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitorder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= uint8(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitorder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitPrice", wireType)
+			}
+			m.LimitPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitorder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitPrice |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitorder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitorder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCancelled = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitorder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLimitorder
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitorder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = append(m.Curator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Curator == nil {
+				m.Curator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLimitorder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLimitorder
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLimitorder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLimitorder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLimitorder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLimitorder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLimitorder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLimitorder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLimitorder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLimitorder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLimitorder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLimitorder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLimitorder = fmt.Errorf("proto: unexpected end of group")
+)
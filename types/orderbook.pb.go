@@ -0,0 +1,684 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: orderbook.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type OrderBook struct {
+	ID       string                                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Index    uint32                                        `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Base     string                                        `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	Quote    string                                        `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+	Mnemonic string                                        `protobuf:"bytes,5,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Curator  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,6,opt,name=curator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"curator,omitempty" yaml:"curator"`
+	IsPaused bool                                          `protobuf:"varint,7,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	// tick_size is the step limit prices must be a multiple of, 0 for any price.
+	TickSize       int64 `protobuf:"varint,8,opt,name=tick_size,json=tickSize,proto3" json:"tick_size,omitempty"`
+	MinOrderAmount int64 `protobuf:"varint,9,opt,name=min_order_amount,json=minOrderAmount,proto3" json:"min_order_amount,omitempty"`
+}
+
+func (m *OrderBook) Reset()      { *m = OrderBook{} }
+func (*OrderBook) ProtoMessage() {}
+func (*OrderBook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aeed55a669e09e60, []int{0}
+}
+func (m *OrderBook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBook.Merge(m, src)
+}
+func (m *OrderBook) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBook) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBook proto.InternalMessageInfo
+
+func (m *OrderBook) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *OrderBook) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *OrderBook) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *OrderBook) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *OrderBook) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *OrderBook) GetCurator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Curator
+	}
+	return nil
+}
+
+func (m *OrderBook) GetIsPaused() bool {
+	if m != nil {
+		return m.IsPaused
+	}
+	return false
+}
+
+func (m *OrderBook) GetTickSize() int64 {
+	if m != nil {
+		return m.TickSize
+	}
+	return 0
+}
+
+func (m *OrderBook) GetMinOrderAmount() int64 {
+	if m != nil {
+		return m.MinOrderAmount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*OrderBook)(nil), "kira.dex.OrderBook")
+}
+
+func init() { proto.RegisterFile("orderbook.proto", fileDescriptor_aeed55a669e09e60) }
+
+var fileDescriptor_aeed55a669e09e60 = []byte{
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xb1, 0xae, 0xd3, 0x30,
+	0x18, 0x85, 0xe3, 0xdc, 0xde, 0xde, 0xc4, 0x82, 0x0b, 0xb2, 0xae, 0x90, 0x55, 0xa4, 0x24, 0xea,
+	0x94, 0xa5, 0xc9, 0xc0, 0x44, 0xb7, 0xa6, 0x2c, 0x88, 0x01, 0x14, 0x36, 0x24, 0x14, 0x39, 0xb1,
+	0x55, 0xac, 0xe0, 0xfc, 0xc5, 0x4e, 0xa4, 0xb6, 0x4f, 0xc1, 0xc8, 0xc8, 0xe3, 0x74, 0xec, 0xc8,
+	0x54, 0xa1, 0xf4, 0x0d, 0x18, 0x99, 0x50, 0x9c, 0x82, 0x98, 0xfc, 0x9f, 0xf3, 0x1d, 0x5b, 0xbf,
+	0x8e, 0xf1, 0x13, 0xd0, 0x5c, 0xe8, 0x12, 0xa0, 0x4e, 0xb6, 0x1a, 0x5a, 0x20, 0x5e, 0x2d, 0x35,
+	0x4b, 0xb8, 0xd8, 0xcd, 0x1e, 0x36, 0xb0, 0x01, 0x6b, 0xa6, 0xc3, 0x34, 0xf2, 0xf9, 0xd1, 0xc5,
+	0xfe, 0xdb, 0xe1, 0x4e, 0x06, 0x50, 0x93, 0x67, 0xd8, 0x95, 0x9c, 0xa2, 0x08, 0xc5, 0x7e, 0x36,
+	0xed, 0xcf, 0xa1, 0xfb, 0xfa, 0x55, 0xee, 0x4a, 0x4e, 0x1e, 0xf0, 0xad, 0x6c, 0xb8, 0xd8, 0x51,
+	0x37, 0x42, 0xf1, 0xe3, 0x7c, 0x14, 0x84, 0xe0, 0x49, 0xc9, 0x8c, 0xa0, 0x37, 0x43, 0x3e, 0xb7,
+	0xf3, 0x90, 0xfc, 0xd2, 0x41, 0x2b, 0xe8, 0xc4, 0x9a, 0xa3, 0x20, 0x33, 0xec, 0xa9, 0x46, 0x28,
+	0x68, 0x64, 0x45, 0x6f, 0x2d, 0xf8, 0xa7, 0xc9, 0x47, 0x7c, 0x57, 0x75, 0x9a, 0xb5, 0xa0, 0xe9,
+	0x34, 0x42, 0xf1, 0xa3, 0x6c, 0xfd, 0xeb, 0x1c, 0xde, 0xef, 0x99, 0xfa, 0xbc, 0x9c, 0x5f, 0xc1,
+	0xfc, 0xf7, 0x39, 0x5c, 0x6c, 0x64, 0xfb, 0xa9, 0x2b, 0x93, 0x0a, 0x54, 0x5a, 0x81, 0x51, 0x60,
+	0xae, 0xc7, 0xc2, 0xf0, 0x3a, 0x6d, 0xf7, 0x5b, 0x61, 0x92, 0x55, 0x55, 0xad, 0x38, 0xd7, 0xc2,
+	0x98, 0xfc, 0xef, 0x9b, 0xe4, 0x39, 0xf6, 0xa5, 0x29, 0xb6, 0xac, 0x33, 0x82, 0xd3, 0xbb, 0x08,
+	0xc5, 0x5e, 0xee, 0x49, 0xf3, 0xce, 0xea, 0x01, 0xb6, 0xb2, 0xaa, 0x0b, 0x23, 0x0f, 0x82, 0x7a,
+	0x11, 0x8a, 0x6f, 0x72, 0x6f, 0x30, 0xde, 0xcb, 0x83, 0x20, 0x31, 0x7e, 0xaa, 0x64, 0x53, 0xd8,
+	0x46, 0x0b, 0xa6, 0xa0, 0x6b, 0x5a, 0xea, 0xdb, 0xcc, 0xbd, 0x92, 0x8d, 0x2d, 0x6d, 0x65, 0xdd,
+	0xe5, 0xe4, 0xdb, 0xf7, 0xd0, 0xc9, 0x5e, 0x1e, 0xfb, 0x00, 0x9d, 0xfa, 0x00, 0xfd, 0xec, 0x03,
+	0xf4, 0xf5, 0x12, 0x38, 0xa7, 0x4b, 0xe0, 0xfc, 0xb8, 0x04, 0xce, 0x87, 0xf0, 0xbf, 0xdd, 0xdf,
+	0x48, 0xcd, 0xd6, 0xa0, 0x45, 0x6a, 0x44, 0xcd, 0xe4, 0xb8, 0x78, 0x39, 0xb5, 0x9f, 0xf1, 0xe2,
+	0xcf, 0x00, 0xa3, 0xfc, 0x2a, 0xaf, 0xbf, 0x01, 0x00, 0x00,
+}
+
+func (m *OrderBook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinOrderAmount != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.MinOrderAmount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TickSize != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.TickSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IsPaused {
+		i--
+		if m.IsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Mnemonic) > 0 {
+		i -= len(m.Mnemonic)
+		copy(dAtA[i:], m.Mnemonic)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.Mnemonic)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrderbook(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrderbook(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OrderBook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovOrderbook(uint64(m.Index))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = len(m.Mnemonic)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	if m.IsPaused {
+		n += 2
+	}
+	if m.TickSize != 0 {
+		n += 1 + sovOrderbook(uint64(m.TickSize))
+	}
+	if m.MinOrderAmount != 0 {
+		n += 1 + sovOrderbook(uint64(m.MinOrderAmount))
+	}
+	return n
+}
+
+func sovOrderbook(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrderbook(x uint64) (n int) {
+	return sovOrderbook(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OrderBook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = append(m.Curator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Curator == nil {
+				m.Curator = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPaused = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			m.TickSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderAmount", wireType)
+			}
+			m.MinOrderAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOrderAmount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOrderbook(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOrderbook
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOrderbook
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOrderbook
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOrderbook        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOrderbook          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOrderbook = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,50 @@
+package dex
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/KiraCore/sekai/x/dex/keeper"
+	"github.com/KiraCore/sekai/x/dex/types"
+)
+
+// BeginBlocker removes the limit orders that expired by the block time and
+// refunds their escrow, so they can not be matched in this block.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	for _, order := range k.ExpireLimitOrders(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireLimitOrder,
+				sdk.NewAttribute(types.AttributeKeyLimitOrderID, order.ID),
+				sdk.NewAttribute(types.AttributeKeyOrderBookID, order.OrderBookID),
+				sdk.NewAttribute(types.AttributeKeyAmount, fmt.Sprintf("%d", order.Amount)),
+			),
+		)
+	}
+}
+
+// EndBlocker matches the limit orders of every order book that is not paused.
+// Order books are visited in store order, so all the validators settle the
+// same fills. The trades that outlived the retention period are pruned from
+// every order book first. An order book whose matching fails is left as it was
+// at the start of the block and the error is logged.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	retention := k.GetParams(ctx).TradeRetention
+	for _, orderBook := range k.GetOrderBooks(ctx) {
+		k.PruneTrades(ctx, orderBook.ID, retention)
+
+		if orderBook.IsPaused {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.MatchOrderBook(cacheCtx, orderBook); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to match order book %s: %s", orderBook.ID, err))
+			continue
+		}
+
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
@@ -0,0 +1,267 @@
+package dex_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/dex"
+	"github.com/KiraCore/sekai/x/dex/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestEndBlocker_MatchesByPriceThenIndex(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.TokensFromConsensusPower(10))
+	seller1, seller2, buyer1, buyer2 := addrs[0], addrs[1], addrs[2], addrs[3]
+	for _, seller := range []sdk.AccAddress{seller1, seller2} {
+		_, err := app.BankKeeper.AddCoins(ctx, seller, sdk.NewCoins(sdk.NewInt64Coin("ukex", 1000)))
+		require.NoError(t, err)
+	}
+	for _, buyer := range []sdk.AccAddress{buyer1, buyer2} {
+		_, err := app.BankKeeper.AddCoins(ctx, buyer, sdk.NewCoins(sdk.NewInt64Coin("ubtc", 10000)))
+		require.NoError(t, err)
+	}
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", seller1)
+
+	handler := dex.NewHandler(app.DexKeeper)
+	for _, msg := range []*types.MsgCreateLimitOrder{
+		types.NewMsgCreateLimitOrder(orderBook.ID, types.OrderTypeLimitSell, 100, 5, 0, seller1),
+		types.NewMsgCreateLimitOrder(orderBook.ID, types.OrderTypeLimitSell, 100, 4, 0, seller2),
+		types.NewMsgCreateLimitOrder(orderBook.ID, types.OrderTypeLimitBuy, 150, 6, 0, buyer1),
+		types.NewMsgCreateLimitOrder(orderBook.ID, types.OrderTypeLimitBuy, 100, 5, 0, buyer2),
+	} {
+		_, err := handler(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	dex.EndBlocker(ctx, app.DexKeeper)
+
+	// buyer1 takes seller2 at 4 and then half of seller1 at 5, buyer2 takes the
+	// rest of seller1 at 5 and keeps 50 resting.
+	fills := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeFill {
+			fills++
+		}
+	}
+	require.Equal(t, 3, fills)
+
+	require.Equal(t, sdk.NewInt(150), app.BankKeeper.GetBalance(ctx, buyer1, "ukex").Amount)
+	require.Equal(t, sdk.NewInt(10000-400-250), app.BankKeeper.GetBalance(ctx, buyer1, "ubtc").Amount)
+	require.Equal(t, sdk.NewInt(50), app.BankKeeper.GetBalance(ctx, buyer2, "ukex").Amount)
+	require.Equal(t, sdk.NewInt(10000-500), app.BankKeeper.GetBalance(ctx, buyer2, "ubtc").Amount)
+	require.Equal(t, sdk.NewInt(500), app.BankKeeper.GetBalance(ctx, seller1, "ubtc").Amount)
+	require.Equal(t, sdk.NewInt(400), app.BankKeeper.GetBalance(ctx, seller2, "ubtc").Amount)
+
+	resting := app.DexKeeper.GetLimitOrdersByOrderBook(ctx, orderBook.ID)
+	require.Len(t, resting, 1)
+	require.Equal(t, buyer2, resting[0].Curator)
+	require.Equal(t, int64(50), resting[0].Amount)
+
+	// Whatever is left in escrow backs the resting buy exactly.
+	moduleAddr := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.Equal(t, sdk.NewInt(250), app.BankKeeper.GetBalance(ctx, moduleAddr, "ubtc").Amount)
+	require.True(t, app.BankKeeper.GetBalance(ctx, moduleAddr, "ukex").IsZero())
+}
+
+func TestEndBlocker_DoesNotMatchUncrossedBook(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+	trader := addrs[0]
+	_, err := app.BankKeeper.AddCoins(ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("ukex", 100), sdk.NewInt64Coin("ubtc", 100)))
+	require.NoError(t, err)
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", trader)
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitBuy, 10, 4, 0, trader)
+	require.NoError(t, err)
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 10, 5, 0, trader)
+	require.NoError(t, err)
+
+	dex.EndBlocker(ctx, app.DexKeeper)
+
+	require.Len(t, app.DexKeeper.GetLimitOrdersByOrderBook(ctx, orderBook.ID), 2)
+}
+
+func TestBeginBlocker_ExpiresLimitOrders(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Time: time.Unix(100, 0)})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+	trader := addrs[0]
+	_, err := app.BankKeeper.AddCoins(ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("ukex", 100), sdk.NewInt64Coin("ubtc", 100)))
+	require.NoError(t, err)
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", trader)
+
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 10, 5, 100, trader)
+	require.True(t, errors.Is(err, types.ErrInvalidExpiryTime))
+
+	expiring, err := app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitBuy, 10, 4, 110, trader)
+	require.NoError(t, err)
+	later, err := app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 10, 5, 120, trader)
+	require.NoError(t, err)
+	forever, err := app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 10, 6, 0, trader)
+	require.NoError(t, err)
+
+	// Nothing expired yet.
+	dex.BeginBlocker(ctx.WithBlockTime(time.Unix(109, 0)), app.DexKeeper)
+	require.Len(t, app.DexKeeper.GetLimitOrdersByOrderBook(ctx, orderBook.ID), 3)
+
+	ctx = ctx.WithBlockTime(time.Unix(110, 0))
+	dex.BeginBlocker(ctx, app.DexKeeper)
+
+	_, found := app.DexKeeper.GetLimitOrder(ctx, expiring.ID)
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, trader, "ubtc").Amount)
+
+	ctx = ctx.WithBlockTime(time.Unix(200, 0))
+	dex.BeginBlocker(ctx, app.DexKeeper)
+
+	_, found = app.DexKeeper.GetLimitOrder(ctx, later.ID)
+	require.False(t, found)
+	orders := app.DexKeeper.GetLimitOrdersByOrderBook(ctx, orderBook.ID)
+	require.Len(t, orders, 1)
+	require.Equal(t, forever.ID, orders[0].ID)
+	require.Equal(t, sdk.NewInt(90), app.BankKeeper.GetBalance(ctx, trader, "ukex").Amount)
+}
+
+func TestEndBlocker_PrunesTradesPastRetention(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Time: time.Unix(100, 0)})
+
+	params := app.DexKeeper.GetParams(ctx)
+	params.TradeRetention = 50
+	app.DexKeeper.SetParams(ctx, params)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+	trader := addrs[0]
+	_, err := app.BankKeeper.AddCoins(ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("ukex", 100), sdk.NewInt64Coin("ubtc", 100)))
+	require.NoError(t, err)
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", trader)
+	trade := func(ctx sdk.Context) {
+		_, err := app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 1, 5, 0, trader)
+		require.NoError(t, err)
+		_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitBuy, 1, 5, 0, trader)
+		require.NoError(t, err)
+		dex.EndBlocker(ctx, app.DexKeeper)
+	}
+
+	trade(ctx)
+	trade(ctx.WithBlockTime(time.Unix(120, 0)))
+
+	// The first trade is kept up to the end of its retention period.
+	dex.EndBlocker(ctx.WithBlockTime(time.Unix(149, 0)), app.DexKeeper)
+	trades, _, err := app.DexKeeper.GetTrades(ctx, orderBook.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, trades, 2)
+
+	dex.EndBlocker(ctx.WithBlockTime(time.Unix(150, 0)), app.DexKeeper)
+	trades, _, err = app.DexKeeper.GetTrades(ctx, orderBook.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+	require.Equal(t, int64(120), trades[0].Time)
+
+	// A retention of zero keeps the trades forever.
+	params.TradeRetention = 0
+	app.DexKeeper.SetParams(ctx, params)
+	dex.EndBlocker(ctx.WithBlockTime(time.Unix(1000, 0)), app.DexKeeper)
+	trades, _, err = app.DexKeeper.GetTrades(ctx, orderBook.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+}
+
+func TestEndBlocker_ChargesMakerAndTakerFees(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(10))
+	curator, seller, buyer := addrs[0], addrs[1], addrs[2]
+	_, err := app.BankKeeper.AddCoins(ctx, seller, sdk.NewCoins(sdk.NewInt64Coin("ukex", 1000)))
+	require.NoError(t, err)
+	_, err = app.BankKeeper.AddCoins(ctx, buyer, sdk.NewCoins(sdk.NewInt64Coin("ubtc", 3000)))
+	require.NoError(t, err)
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", curator)
+
+	params := types.DefaultParams()
+	params.CuratorFeeShare = sdk.NewDecWithPrec(5, 1)
+	params.OrderBookFees = []types.OrderBookFee{
+		{OrderBookID: orderBook.ID, MakerFeeRate: sdk.NewDecWithPrec(1, 2), TakerFeeRate: sdk.NewDecWithPrec(2, 2)},
+	}
+	app.DexKeeper.SetParams(ctx, params)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	collected := app.BankKeeper.GetAllBalances(ctx, feeCollector)
+
+	// The seller rests first, so it is the maker and the buyer the taker.
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 1000, 2, 0, seller)
+	require.NoError(t, err)
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitBuy, 1000, 3, 0, buyer)
+	require.NoError(t, err)
+
+	dex.EndBlocker(ctx, app.DexKeeper)
+
+	// The buyer pays 2% of 1000ukex and gets back the 1000ubtc escrowed above the execution price.
+	require.Equal(t, sdk.NewInt(980), app.BankKeeper.GetBalance(ctx, buyer, "ukex").Amount)
+	require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetBalance(ctx, buyer, "ubtc").Amount)
+	// The seller pays 1% of 2000ubtc.
+	require.Equal(t, sdk.NewInt(1980), app.BankKeeper.GetBalance(ctx, seller, "ubtc").Amount)
+
+	require.Equal(t, sdk.NewInt(10), app.BankKeeper.GetBalance(ctx, curator, "ukex").Amount)
+	require.Equal(t, sdk.NewInt(10), app.BankKeeper.GetBalance(ctx, curator, "ubtc").Amount)
+	require.Equal(t,
+		collected.Add(sdk.NewCoins(sdk.NewInt64Coin("ukex", 10), sdk.NewInt64Coin("ubtc", 10))...),
+		app.BankKeeper.GetAllBalances(ctx, feeCollector),
+	)
+}
+
+func TestEndBlocker_LeavesOrderBooksFailingToPayOut(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+	seller, buyer := addrs[0], addrs[1]
+	_, err := app.BankKeeper.AddCoins(ctx, seller, sdk.NewCoins(sdk.NewInt64Coin("ukex", 1000)))
+	require.NoError(t, err)
+	_, err = app.BankKeeper.AddCoins(ctx, buyer, sdk.NewCoins(sdk.NewInt64Coin("ubtc", 5000)))
+	require.NoError(t, err)
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", seller)
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 1000, 5, 0, seller)
+	require.NoError(t, err)
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitBuy, 1000, 5, 0, buyer)
+	require.NoError(t, err)
+
+	// An escrow that no longer covers the payouts, as a broken invariant
+	// would leave it.
+	dexAddr := authtypes.NewModuleAddress(types.ModuleName)
+	shortfall := sdk.NewCoins(sdk.NewInt64Coin("ukex", 1000))
+	_, err = app.BankKeeper.SubtractCoins(ctx, dexAddr, shortfall)
+	require.NoError(t, err)
+
+	require.NotPanics(t, func() { dex.EndBlocker(ctx, app.DexKeeper) })
+	require.Len(t, app.DexKeeper.GetLimitOrdersByOrderBook(ctx, orderBook.ID), 2)
+	require.True(t, app.BankKeeper.GetBalance(ctx, buyer, "ukex").IsZero())
+	require.True(t, app.BankKeeper.GetBalance(ctx, seller, "ubtc").IsZero())
+	require.Equal(t, sdk.NewInt(5000), app.BankKeeper.GetBalance(ctx, dexAddr, "ubtc").Amount)
+
+	// Matching resumes once the escrow is restored.
+	_, err = app.BankKeeper.AddCoins(ctx, dexAddr, shortfall)
+	require.NoError(t, err)
+	dex.EndBlocker(ctx, app.DexKeeper)
+	require.Empty(t, app.DexKeeper.GetLimitOrdersByOrderBook(ctx, orderBook.ID))
+	require.False(t, app.BankKeeper.GetBalance(ctx, buyer, "ukex").IsZero())
+}
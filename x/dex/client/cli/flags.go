@@ -0,0 +1,20 @@
+package cli
+
+const (
+	FlagBase     = "base"
+	FlagQuote    = "quote"
+	FlagMnemonic = "mnemonic"
+	FlagID       = "id"
+	FlagCurator  = "curator"
+
+	FlagOrderBookID = "order-book-id"
+	FlagOrderType   = "order-type"
+	FlagAmount      = "amount"
+	FlagLimitPrice  = "limit-price"
+	FlagExpiryTime  = "expiry-time"
+
+	FlagLevels = "levels"
+
+	FlagTickSize       = "tick-size"
+	FlagMinOrderAmount = "min-order-amount"
+)
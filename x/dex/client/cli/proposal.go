@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/KiraCore/sekai/x/dex/types"
+)
+
+// GetCmdSubmitDelistOrderBookProposal the submit delist order book proposal command.
+func GetCmdSubmitDelistOrderBookProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delist-order-book [order-book-id]",
+		Short: "Submit a proposal to delist an order book and refund its limit orders",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			title, _ := cmd.Flags().GetString(govcli.FlagTitle)
+			description, _ := cmd.Flags().GetString(govcli.FlagDescription)
+
+			content := types.NewDelistOrderBookProposal(title, description, args[0])
+			return submitProposal(clientCtx, cmd, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitReassignOrderBookProposal the submit reassign order book proposal command.
+func GetCmdSubmitReassignOrderBookProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reassign-order-book [order-book-id] [new-curator]",
+		Short: "Submit a proposal to hand an order book to a new curator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			newCurator, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.Wrap(err, "invalid new curator address")
+			}
+
+			title, _ := cmd.Flags().GetString(govcli.FlagTitle)
+			description, _ := cmd.Flags().GetString(govcli.FlagDescription)
+
+			content := types.NewReassignOrderBookProposal(title, description, args[0], newCurator)
+			return submitProposal(clientCtx, cmd, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func submitProposal(clientCtx client.Context, cmd *cobra.Command, content govtypes.Content) error {
+	depositStr, _ := cmd.Flags().GetString(govcli.FlagDeposit)
+	deposit, err := sdk.ParseCoins(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "the proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "the proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "the proposal deposit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+}
@@ -0,0 +1,342 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/KiraCore/sekai/x/dex/types"
+)
+
+// GetQueryCmd returns the parent command for all x/dex query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the dex module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryOrderBookByID(),
+		GetCmdQueryOrderBooks(),
+		GetCmdQueryLimitOrderByID(),
+		GetCmdQueryLimitOrders(),
+		GetCmdQueryDepth(),
+		GetCmdQueryBestBidAsk(),
+		GetCmdQueryTrades(),
+		GetCmdQueryParams(),
+		GetCmdQueryOrderBookFees(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryOrderBookByID the query order book command.
+func GetCmdQueryOrderBookByID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-book [id]",
+		Short: "Query an order book by its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.OrderBookByIDRequest{ID: args[0]}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OrderBookByID(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.OrderBook)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryOrderBooks the query order books by pair or by curator command.
+func GetCmdQueryOrderBooks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-books [--base && --quote || --curator]",
+		Short: "Query the order books of a base/quote pair or of a curator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			curator, _ := cmd.Flags().GetString(FlagCurator)
+			if curator != "" {
+				curatorAddr, err := sdk.AccAddressFromBech32(curator)
+				if err != nil {
+					return errors.Wrap(err, "invalid curator address")
+				}
+
+				params := &types.OrderBooksByCuratorRequest{Curator: curatorAddr}
+				res, err := queryClient.OrderBooksByCurator(context.Background(), params)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintOutput(res)
+			}
+
+			base, _ := cmd.Flags().GetString(FlagBase)
+			quote, _ := cmd.Flags().GetString(FlagQuote)
+			if base == "" || quote == "" {
+				return fmt.Errorf("either --curator or both --base and --quote need to be set")
+			}
+
+			params := &types.OrderBooksByPairRequest{Base: base, Quote: quote}
+			res, err := queryClient.OrderBooksByPair(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	cmd.Flags().String(FlagBase, "", "the base denom")
+	cmd.Flags().String(FlagQuote, "", "the quote denom")
+	cmd.Flags().String(FlagCurator, "", "the curator address")
+
+	return cmd
+}
+
+// GetCmdQueryLimitOrderByID the query limit order command.
+func GetCmdQueryLimitOrderByID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-order [id]",
+		Short: "Query a limit order by its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.LimitOrderByIDRequest{ID: args[0]}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.LimitOrderByID(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.LimitOrder)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryLimitOrders the query resting limit orders of an order book command.
+func GetCmdQueryLimitOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-orders [order-book-id]",
+		Short: "Query the limit orders resting on an order book",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.LimitOrdersByOrderBookRequest{OrderBookID: args[0]}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.LimitOrdersByOrderBook(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDepth the query order book depth command.
+func GetCmdQueryDepth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "depth [order-book-id]",
+		Short: "Query the resting amount of an order book aggregated by price level",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			levels, _ := cmd.Flags().GetUint32(FlagLevels)
+			params := &types.DepthRequest{OrderBookID: args[0], Limit: levels}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Depth(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	cmd.Flags().Uint32(FlagLevels, 0, "the maximum number of price levels of each side, 0 for all of them")
+
+	return cmd
+}
+
+// GetCmdQueryBestBidAsk the query best bid, best ask and spread command.
+func GetCmdQueryBestBidAsk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "best-bid-ask [order-book-id]",
+		Short: "Query the best bid, the best ask and the spread of an order book",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.BestBidAskRequest{OrderBookID: args[0]}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BestBidAsk(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTrades the query recent trades of an order book command.
+func GetCmdQueryTrades() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades [order-book-id]",
+		Short: "Query the trades of an order book, most recent first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.TradesRequest{OrderBookID: args[0], Pagination: pageReq}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Trades(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trades")
+
+	return cmd
+}
+
+// GetCmdQueryParams the query dex params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the parameters of the dex module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.ParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryOrderBookFees the query order book fees command.
+func GetCmdQueryOrderBookFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fees [order-book-id]",
+		Short: "Query the maker and taker fee rates of an order book",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.OrderBookFeesRequest{OrderBookID: args[0]}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OrderBookFees(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
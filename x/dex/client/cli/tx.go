@@ -0,0 +1,277 @@
+package cli
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/KiraCore/sekai/x/dex/types"
+)
+
+// GetTxCmd returns the parent command for all x/dex transaction commands.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Dex transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetTxCreateOrderBookCmd(),
+		GetTxCreateLimitOrderCmd(),
+		GetTxCancelLimitOrderCmd(),
+		GetTxPauseOrderBookCmd(),
+		GetTxResumeOrderBookCmd(),
+		GetTxSetOrderBookLimitsCmd(),
+		GetTxSetOrderBookMnemonicCmd(),
+		GetTxTransferOrderBookCuratorshipCmd(),
+	)
+
+	return cmd
+}
+
+func GetTxCreateOrderBookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-order-book",
+		Short: "Create an order book curated by the sender",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			base, _ := cmd.Flags().GetString(FlagBase)
+			quote, _ := cmd.Flags().GetString(FlagQuote)
+			mnemonic, _ := cmd.Flags().GetString(FlagMnemonic)
+
+			msg := types.NewMsgCreateOrderBook(base, quote, mnemonic, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBase, "", "the base denom")
+	cmd.Flags().String(FlagQuote, "", "the quote denom")
+	cmd.Flags().String(FlagMnemonic, "", "the order book mnemonic")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagBase)
+	_ = cmd.MarkFlagRequired(FlagQuote)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxCreateLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-limit-order",
+		Short: "Post an order on an order book, escrowing the offered funds of limit orders",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			orderBookID, _ := cmd.Flags().GetString(FlagOrderBookID)
+			orderTypeStr, _ := cmd.Flags().GetString(FlagOrderType)
+			amount, _ := cmd.Flags().GetInt64(FlagAmount)
+			limitPrice, _ := cmd.Flags().GetInt64(FlagLimitPrice)
+			expiryTime, _ := cmd.Flags().GetInt64(FlagExpiryTime)
+
+			orderType, err := types.OrderTypeFromString(orderTypeStr)
+			if err != nil {
+				return errors.Wrapf(err, "invalid --%s", FlagOrderType)
+			}
+
+			msg := types.NewMsgCreateLimitOrder(orderBookID, orderType, amount, limitPrice, expiryTime, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagOrderBookID, "", "the order book id")
+	cmd.Flags().String(FlagOrderType, "", "the order type (limit-buy|limit-sell|market-buy|market-sell|ioc-buy|ioc-sell|fok-buy|fok-sell)")
+	cmd.Flags().Int64(FlagAmount, 0, "the amount of base to buy or sell")
+	cmd.Flags().Int64(FlagLimitPrice, 0, "the limit price in quote per base, 0 for market orders")
+	cmd.Flags().Int64(FlagExpiryTime, 0, "the expiry time of the order as a unix timestamp, 0 for no expiry")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagOrderBookID)
+	_ = cmd.MarkFlagRequired(FlagOrderType)
+	_ = cmd.MarkFlagRequired(FlagAmount)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxCancelLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-limit-order [id]",
+		Short: "Cancel a limit order and refund its escrowed funds",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelLimitOrder(args[0], clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxPauseOrderBookCmd() *cobra.Command {
+	return newSetOrderBookPausedCmd("pause-order-book [order-book-id]", "Pause trading on an order book curated by the sender", true)
+}
+
+func GetTxResumeOrderBookCmd() *cobra.Command {
+	return newSetOrderBookPausedCmd("resume-order-book [order-book-id]", "Resume trading on an order book curated by the sender", false)
+}
+
+func newSetOrderBookPausedCmd(use string, short string, isPaused bool) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetOrderBookPaused(args[0], isPaused, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxSetOrderBookLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-order-book-limits [order-book-id]",
+		Short: "Set the tick size and the minimum order amount of an order book curated by the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			tickSize, _ := cmd.Flags().GetInt64(FlagTickSize)
+			minOrderAmount, _ := cmd.Flags().GetInt64(FlagMinOrderAmount)
+
+			msg := types.NewMsgSetOrderBookLimits(args[0], tickSize, minOrderAmount, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagTickSize, 0, "the step limit prices must be a multiple of, 0 for any price")
+	cmd.Flags().Int64(FlagMinOrderAmount, 0, "the minimum amount of a limit order")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxSetOrderBookMnemonicCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-order-book-mnemonic [order-book-id] [mnemonic]",
+		Short: "Update the mnemonic of an order book curated by the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetOrderBookMnemonic(args[0], args[1], clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxTransferOrderBookCuratorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-order-book-curatorship [order-book-id] [new-curator]",
+		Short: "Hand an order book curated by the sender to a new curator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			newCurator, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.Wrap(err, "invalid new curator address")
+			}
+
+			msg := types.NewMsgTransferOrderBookCuratorship(args[0], newCurator, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
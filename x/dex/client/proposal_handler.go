@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/KiraCore/sekai/x/dex/client/cli"
+	"github.com/KiraCore/sekai/x/dex/client/rest"
+)
+
+// Proposal handlers of the dex governance proposals.
+var (
+	DelistOrderBookProposalHandler   = govclient.NewProposalHandler(cli.GetCmdSubmitDelistOrderBookProposal, rest.DelistOrderBookProposalRESTHandler)
+	ReassignOrderBookProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitReassignOrderBookProposal, rest.ReassignOrderBookProposalRESTHandler)
+)
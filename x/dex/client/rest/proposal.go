@@ -0,0 +1,88 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/KiraCore/sekai/x/dex/types"
+)
+
+type (
+	// DelistOrderBookProposalReq defines a delist order book proposal request body.
+	DelistOrderBookProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		OrderBookID string         `json:"order_book_id" yaml:"order_book_id"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// ReassignOrderBookProposalReq defines a reassign order book proposal request body.
+	ReassignOrderBookProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		OrderBookID string         `json:"order_book_id" yaml:"order_book_id"`
+		NewCurator  sdk.AccAddress `json:"new_curator" yaml:"new_curator"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// DelistOrderBookProposalRESTHandler returns the REST handler submitting delist order book proposals.
+func DelistOrderBookProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "delist_order_book",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req DelistOrderBookProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewDelistOrderBookProposal(req.Title, req.Description, req.OrderBookID)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// ReassignOrderBookProposalRESTHandler returns the REST handler submitting reassign order book proposals.
+func ReassignOrderBookProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "reassign_order_book",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req ReassignOrderBookProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewReassignOrderBookProposal(req.Title, req.Description, req.OrderBookID, req.NewCurator)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+func writeProposalTx(clientCtx client.Context, w http.ResponseWriter, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins, proposer sdk.AccAddress) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
@@ -0,0 +1,61 @@
+package dex
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	kiratypes "github.com/KiraCore/sekai/types"
+	"github.com/KiraCore/sekai/x/dex/keeper"
+	"github.com/KiraCore/sekai/x/dex/types"
+)
+
+// InitGenesis stores the order books, the limit orders resting on them and
+// their trade histories.
+// The funds backing the limit orders are expected in the dex module account.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+
+	for _, orderBook := range data.OrderBooks {
+		k.SetOrderBook(ctx, orderBook)
+	}
+
+	for _, order := range data.LimitOrders {
+		k.SetLimitOrder(ctx, order)
+	}
+
+	for _, trade := range data.Trades {
+		k.SetTrade(ctx, trade)
+	}
+
+	k.SetLastOrderBookIndex(ctx, data.LastOrderBookIndex)
+	k.SetLastLimitOrderIndex(ctx, data.LastLimitOrderIndex)
+	k.SetLastTradeIndex(ctx, data.LastTradeIndex)
+}
+
+// ExportGenesis returns the order books, their resting limit orders, their
+// trade histories and the sequence counters.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	orderBooks := k.GetOrderBooks(ctx)
+	if orderBooks == nil {
+		orderBooks = []kiratypes.OrderBook{}
+	}
+
+	limitOrders := k.GetLimitOrders(ctx)
+	if limitOrders == nil {
+		limitOrders = []kiratypes.LimitOrder{}
+	}
+
+	trades := k.GetAllTrades(ctx)
+	if trades == nil {
+		trades = []types.Trade{}
+	}
+
+	return types.NewGenesisState(
+		k.GetParams(ctx),
+		orderBooks,
+		limitOrders,
+		k.GetLastOrderBookIndex(ctx),
+		k.GetLastLimitOrderIndex(ctx),
+		k.GetLastTradeIndex(ctx),
+		trades,
+	)
+}
@@ -0,0 +1,66 @@
+package dex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	kiratypes "github.com/KiraCore/sekai/types"
+	"github.com/KiraCore/sekai/x/dex"
+	"github.com/KiraCore/sekai/x/dex/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesis_ExportImportRoundTrip(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+	trader := addrs[0]
+	_, err := app.BankKeeper.AddCoins(ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("ukex", 100), sdk.NewInt64Coin("ubtc", 100)))
+	require.NoError(t, err)
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "KEX/BTC", trader)
+	app.DexKeeper.CreateOrderBook(ctx, "ukex", "ueth", "", trader)
+
+	resting, err := app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitBuy, 10, 4, 500, trader)
+	require.NoError(t, err)
+	cancelled, err := app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 10, 5, 0, trader)
+	require.NoError(t, err)
+	_, err = app.DexKeeper.CancelLimitOrder(ctx, cancelled.ID, trader)
+	require.NoError(t, err)
+
+	// A filled order leaves only its trade behind.
+	filled, err := app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 4, 5, 0, trader)
+	require.NoError(t, err)
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeIOCBuy, 4, 5, 0, trader)
+	require.NoError(t, err)
+
+	exported := dex.ExportGenesis(ctx, app.DexKeeper)
+	require.NoError(t, types.ValidateGenesis(*exported))
+	require.Len(t, exported.OrderBooks, 2)
+	require.Equal(t, []kiratypes.LimitOrder{resting}, exported.LimitOrders)
+	require.Equal(t, uint32(2), exported.LastOrderBookIndex)
+	require.Equal(t, uint32(4), exported.LastLimitOrderIndex)
+	require.Len(t, exported.Trades, 1)
+	require.Equal(t, filled.ID, exported.Trades[0].SellOrderID)
+	require.Equal(t, uint64(1), exported.LastTradeIndex)
+
+	app2 := simapp.Setup(false)
+	ctx2 := app2.NewContext(false, tmproto.Header{})
+	dex.InitGenesis(ctx2, app2.DexKeeper, *exported)
+
+	require.Equal(t, exported, dex.ExportGenesis(ctx2, app2.DexKeeper))
+	require.Equal(t, app.DexKeeper.GetOrderBooksByCurator(ctx, trader), app2.DexKeeper.GetOrderBooksByCurator(ctx2, trader))
+	require.Equal(t, []kiratypes.LimitOrder{resting}, app2.DexKeeper.GetLimitOrdersByOrderBook(ctx2, orderBook.ID))
+
+	trades, _, err := app2.DexKeeper.GetTrades(ctx2, orderBook.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, exported.Trades, trades)
+
+	// The sequences continue where the exported chain stopped.
+	next := app2.DexKeeper.CreateOrderBook(ctx2, "ukex", "uatom", "", trader)
+	require.Equal(t, uint32(3), next.Index)
+}
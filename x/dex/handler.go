@@ -0,0 +1,171 @@
+package dex
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/KiraCore/sekai/x/dex/keeper"
+	"github.com/KiraCore/sekai/x/dex/types"
+)
+
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgCreateOrderBook:
+			return handleMsgCreateOrderBook(ctx, k, msg)
+		case *types.MsgCreateLimitOrder:
+			return handleMsgCreateLimitOrder(ctx, k, msg)
+		case *types.MsgCancelLimitOrder:
+			return handleMsgCancelLimitOrder(ctx, k, msg)
+		case *types.MsgSetOrderBookPaused:
+			return handleMsgSetOrderBookPaused(ctx, k, msg)
+		case *types.MsgSetOrderBookLimits:
+			return handleMsgSetOrderBookLimits(ctx, k, msg)
+		case *types.MsgSetOrderBookMnemonic:
+			return handleMsgSetOrderBookMnemonic(ctx, k, msg)
+		case *types.MsgTransferOrderBookCuratorship:
+			return handleMsgTransferOrderBookCuratorship(ctx, k, msg)
+		default:
+			return nil, errors.Wrapf(errors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
+
+func handleMsgCreateOrderBook(ctx sdk.Context, k keeper.Keeper, msg *types.MsgCreateOrderBook) (*sdk.Result, error) {
+	orderBook := k.CreateOrderBook(ctx, msg.Base, msg.Quote, msg.Mnemonic, msg.Curator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateOrderBook,
+			sdk.NewAttribute(types.AttributeKeyOrderBookID, orderBook.ID),
+			sdk.NewAttribute(types.AttributeKeyIndex, fmt.Sprintf("%d", orderBook.Index)),
+			sdk.NewAttribute(types.AttributeKeyCurator, orderBook.Curator.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgCreateLimitOrder(ctx sdk.Context, k keeper.Keeper, msg *types.MsgCreateLimitOrder) (*sdk.Result, error) {
+	order, err := k.CreateLimitOrder(ctx, msg.OrderBookID, msg.OrderType, msg.Amount, msg.LimitPrice, msg.ExpiryTime, msg.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Market, IOC and FOK orders are executed right away and never stored.
+	if !types.IsLimitOrderType(order.OrderType) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExecuteImmediateOrder,
+				sdk.NewAttribute(types.AttributeKeyLimitOrderID, order.ID),
+				sdk.NewAttribute(types.AttributeKeyOrderBookID, order.OrderBookID),
+				sdk.NewAttribute(types.AttributeKeyCurator, order.Curator.String()),
+				sdk.NewAttribute(types.AttributeKeyFilledAmount, fmt.Sprintf("%d", msg.Amount-order.Amount)),
+				sdk.NewAttribute(types.AttributeKeyUnfilledAmount, fmt.Sprintf("%d", order.Amount)),
+			),
+		)
+
+		return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyLimitOrderID, order.ID),
+			sdk.NewAttribute(types.AttributeKeyOrderBookID, order.OrderBookID),
+			sdk.NewAttribute(types.AttributeKeyIndex, fmt.Sprintf("%d", order.Index)),
+			sdk.NewAttribute(types.AttributeKeyCurator, order.Curator.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgCancelLimitOrder(ctx sdk.Context, k keeper.Keeper, msg *types.MsgCancelLimitOrder) (*sdk.Result, error) {
+	order, err := k.CancelLimitOrder(ctx, msg.LimitOrderID, msg.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyLimitOrderID, order.ID),
+			sdk.NewAttribute(types.AttributeKeyOrderBookID, order.OrderBookID),
+			sdk.NewAttribute(types.AttributeKeyAmount, fmt.Sprintf("%d", order.Amount)),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgSetOrderBookPaused(ctx sdk.Context, k keeper.Keeper, msg *types.MsgSetOrderBookPaused) (*sdk.Result, error) {
+	orderBook, err := k.SetOrderBookPaused(ctx, msg.OrderBookID, msg.IsPaused, msg.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetOrderBookPaused,
+			sdk.NewAttribute(types.AttributeKeyOrderBookID, orderBook.ID),
+			sdk.NewAttribute(types.AttributeKeyIsPaused, fmt.Sprintf("%t", orderBook.IsPaused)),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgSetOrderBookLimits(ctx sdk.Context, k keeper.Keeper, msg *types.MsgSetOrderBookLimits) (*sdk.Result, error) {
+	orderBook, err := k.SetOrderBookLimits(ctx, msg.OrderBookID, msg.TickSize, msg.MinOrderAmount, msg.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetOrderBookLimits,
+			sdk.NewAttribute(types.AttributeKeyOrderBookID, orderBook.ID),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgSetOrderBookMnemonic(ctx sdk.Context, k keeper.Keeper, msg *types.MsgSetOrderBookMnemonic) (*sdk.Result, error) {
+	orderBook, err := k.SetOrderBookMnemonic(ctx, msg.OrderBookID, msg.Mnemonic, msg.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetOrderBookMnemonic,
+			sdk.NewAttribute(types.AttributeKeyOrderBookID, orderBook.ID),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgTransferOrderBookCuratorship(ctx sdk.Context, k keeper.Keeper, msg *types.MsgTransferOrderBookCuratorship) (*sdk.Result, error) {
+	orderBook, err := k.TransferOrderBookCuratorship(ctx, msg.OrderBookID, msg.NewCurator, msg.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferOrderBookCuratorship,
+			sdk.NewAttribute(types.AttributeKeyOrderBookID, orderBook.ID),
+			sdk.NewAttribute(types.AttributeKeyCurator, msg.Curator.String()),
+			sdk.NewAttribute(types.AttributeKeyNewCurator, orderBook.Curator.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
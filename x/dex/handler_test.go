@@ -0,0 +1,205 @@
+package dex_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/app"
+	"github.com/KiraCore/sekai/simapp"
+	kiratypes "github.com/KiraCore/sekai/types"
+	"github.com/KiraCore/sekai/x/dex"
+	"github.com/KiraCore/sekai/x/dex/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMain(m *testing.M) {
+	app.SetConfig()
+	os.Exit(m.Run())
+}
+
+func TestNewHandler_MsgCreateOrderBook_HappyPath(t *testing.T) {
+	curator, err := sdk.AccAddressFromBech32("kira15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqzp4f3d")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	handler := dex.NewHandler(app.DexKeeper)
+
+	theMsg := types.NewMsgCreateOrderBook("ukex", "ubtc", "KEX/BTC", curator)
+	require.NoError(t, theMsg.ValidateBasic())
+
+	_, err = handler(ctx, theMsg)
+	require.NoError(t, err)
+
+	orderBooks := app.DexKeeper.GetOrderBooksByCurator(ctx, curator)
+	require.Len(t, orderBooks, 1)
+
+	orderBook := orderBooks[0]
+	require.Equal(t, uint32(1), orderBook.Index)
+	require.NotEmpty(t, orderBook.ID)
+	require.Equal(t, theMsg.Base, orderBook.Base)
+	require.Equal(t, theMsg.Quote, orderBook.Quote)
+	require.Equal(t, theMsg.Mnemonic, orderBook.Mnemonic)
+	require.Equal(t, theMsg.Curator, orderBook.Curator)
+
+	// A second order book for the same pair gets the next index and a new ID.
+	_, err = handler(ctx, theMsg)
+	require.NoError(t, err)
+
+	orderBooks = app.DexKeeper.GetOrderBooksByPair(ctx, "ukex", "ubtc")
+	require.Len(t, orderBooks, 2)
+	require.NotEqual(t, orderBooks[0].ID, orderBooks[1].ID)
+	require.Equal(t, uint32(2), app.DexKeeper.GetLastOrderBookIndex(ctx))
+}
+
+func TestNewHandler_MsgCreateLimitOrder_EscrowAndCancel(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+	buyer, seller := addrs[0], addrs[1]
+	_, err := app.BankKeeper.AddCoins(ctx, buyer, sdk.NewCoins(sdk.NewInt64Coin("ubtc", 1000)))
+	require.NoError(t, err)
+	_, err = app.BankKeeper.AddCoins(ctx, seller, sdk.NewCoins(sdk.NewInt64Coin("ukex", 1000)))
+	require.NoError(t, err)
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", seller)
+	handler := dex.NewHandler(app.DexKeeper)
+
+	// A buy escrows amount*price of quote, a sell escrows amount of base.
+	_, err = handler(ctx, types.NewMsgCreateLimitOrder(orderBook.ID, types.OrderTypeLimitBuy, 100, 3, 0, buyer))
+	require.NoError(t, err)
+	_, err = handler(ctx, types.NewMsgCreateLimitOrder(orderBook.ID, types.OrderTypeLimitSell, 400, 5, 0, seller))
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewInt(700), app.BankKeeper.GetBalance(ctx, buyer, "ubtc").Amount)
+	require.Equal(t, sdk.NewInt(600), app.BankKeeper.GetBalance(ctx, seller, "ukex").Amount)
+
+	orders := app.DexKeeper.GetLimitOrdersByOrderBook(ctx, orderBook.ID)
+	require.Len(t, orders, 2)
+
+	// Not enough funds to escrow.
+	_, err = handler(ctx, types.NewMsgCreateLimitOrder(orderBook.ID, types.OrderTypeLimitSell, 601, 5, 0, seller))
+	require.Error(t, err)
+
+	// Unknown order book.
+	_, err = handler(ctx, types.NewMsgCreateLimitOrder("unknown", types.OrderTypeLimitSell, 1, 5, 0, seller))
+	require.True(t, types.ErrOrderBookNotFound.Is(err))
+
+	buyOrder := orders[0]
+	if buyOrder.Curator.Equals(seller) {
+		buyOrder = orders[1]
+	}
+
+	// Only the curator of the order can cancel it.
+	_, err = handler(ctx, types.NewMsgCancelLimitOrder(buyOrder.ID, seller))
+	require.Error(t, err)
+
+	_, err = handler(ctx, types.NewMsgCancelLimitOrder(buyOrder.ID, buyer))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetBalance(ctx, buyer, "ubtc").Amount)
+	require.Len(t, app.DexKeeper.GetLimitOrdersByOrderBook(ctx, orderBook.ID), 1)
+
+	// Cancelled orders are deleted once refunded.
+	_, found := app.DexKeeper.GetLimitOrder(ctx, buyOrder.ID)
+	require.False(t, found)
+	require.Len(t, app.DexKeeper.GetLimitOrders(ctx), 1)
+
+	_, err = handler(ctx, types.NewMsgCancelLimitOrder(buyOrder.ID, buyer))
+	require.True(t, types.ErrLimitOrderNotFound.Is(err))
+}
+
+func TestNewHandler_CuratorActions(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(10))
+	curator, newCurator, trader := addrs[0], addrs[1], addrs[2]
+	_, err := app.BankKeeper.AddCoins(ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("ukex", 1000)))
+	require.NoError(t, err)
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", curator)
+	handler := dex.NewHandler(app.DexKeeper)
+
+	// Only the curator can manage the order book.
+	_, err = handler(ctx, types.NewMsgSetOrderBookPaused(orderBook.ID, true, trader))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	_, err = handler(ctx, types.NewMsgSetOrderBookPaused(orderBook.ID, true, curator))
+	require.NoError(t, err)
+	_, err = handler(ctx, types.NewMsgCreateLimitOrder(orderBook.ID, types.OrderTypeLimitSell, 10, 5, 0, trader))
+	require.True(t, types.ErrOrderBookPaused.Is(err))
+
+	_, err = handler(ctx, types.NewMsgSetOrderBookPaused(orderBook.ID, false, curator))
+	require.NoError(t, err)
+
+	_, err = handler(ctx, types.NewMsgSetOrderBookLimits(orderBook.ID, 5, 10, curator))
+	require.NoError(t, err)
+	_, err = handler(ctx, types.NewMsgCreateLimitOrder(orderBook.ID, types.OrderTypeLimitSell, 10, 7, 0, trader))
+	require.True(t, types.ErrInvalidLimitPrice.Is(err))
+	_, err = handler(ctx, types.NewMsgCreateLimitOrder(orderBook.ID, types.OrderTypeLimitSell, 9, 5, 0, trader))
+	require.True(t, types.ErrInvalidAmount.Is(err))
+	_, err = handler(ctx, types.NewMsgCreateLimitOrder(orderBook.ID, types.OrderTypeLimitSell, 10, 15, 0, trader))
+	require.NoError(t, err)
+
+	_, err = handler(ctx, types.NewMsgSetOrderBookMnemonic(orderBook.ID, "KEX/BTC", curator))
+	require.NoError(t, err)
+
+	_, err = handler(ctx, types.NewMsgTransferOrderBookCuratorship(orderBook.ID, authtypes.NewModuleAddress(types.ModuleName), curator))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	_, err = handler(ctx, types.NewMsgTransferOrderBookCuratorship(orderBook.ID, newCurator, curator))
+	require.NoError(t, err)
+	_, err = handler(ctx, types.NewMsgSetOrderBookPaused(orderBook.ID, true, curator))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	updated, found := app.DexKeeper.GetOrderBook(ctx, orderBook.ID)
+	require.True(t, found)
+	require.Equal(t, "KEX/BTC", updated.Mnemonic)
+	require.Equal(t, int64(5), updated.TickSize)
+	require.Equal(t, int64(10), updated.MinOrderAmount)
+	require.Equal(t, newCurator, updated.Curator)
+
+	require.Empty(t, app.DexKeeper.GetOrderBooksByCurator(ctx, curator))
+	require.Equal(t, []kiratypes.OrderBook{updated}, app.DexKeeper.GetOrderBooksByCurator(ctx, newCurator))
+}
+
+func TestNewHandler_MsgCreateLimitOrder_ImmediateOrderEvents(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+	buyer, seller := addrs[0], addrs[1]
+	_, err := app.BankKeeper.AddCoins(ctx, buyer, sdk.NewCoins(sdk.NewInt64Coin("ubtc", 1000)))
+	require.NoError(t, err)
+	_, err = app.BankKeeper.AddCoins(ctx, seller, sdk.NewCoins(sdk.NewInt64Coin("ukex", 1000)))
+	require.NoError(t, err)
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", seller)
+	handler := dex.NewHandler(app.DexKeeper)
+
+	res, err := handler(ctx, types.NewMsgCreateLimitOrder(orderBook.ID, types.OrderTypeLimitSell, 40, 5, 0, seller))
+	require.NoError(t, err)
+	require.Equal(t, types.EventTypeCreateLimitOrder, res.Events[len(res.Events)-1].Type)
+
+	// An IOC buy of 100 fills the 40 resting and drops the rest without storing anything.
+	res, err = handler(ctx, types.NewMsgCreateLimitOrder(orderBook.ID, types.OrderTypeIOCBuy, 100, 5, 0, buyer))
+	require.NoError(t, err)
+	require.Empty(t, app.DexKeeper.GetLimitOrdersByOrderBook(ctx, orderBook.ID))
+
+	var immediate []sdk.Attribute
+	for _, event := range sdk.StringifyEvents(res.Events) {
+		require.NotEqual(t, types.EventTypeCreateLimitOrder, event.Type)
+		if event.Type == types.EventTypeExecuteImmediateOrder {
+			immediate = event.Attributes
+		}
+	}
+	require.Contains(t, immediate, sdk.NewAttribute(types.AttributeKeyFilledAmount, "40"))
+	require.Contains(t, immediate, sdk.NewAttribute(types.AttributeKeyUnfilledAmount, "60"))
+}
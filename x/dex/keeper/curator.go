@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	kiratypes "github.com/KiraCore/sekai/types"
+	"github.com/KiraCore/sekai/x/dex/types"
+)
+
+// SetOrderBookPaused pauses or resumes trading on an order book. A paused
+// order book rejects new limit orders and is not matched, but its limit
+// orders can still be cancelled or expire.
+func (k Keeper) SetOrderBookPaused(ctx sdk.Context, id string, isPaused bool, curator sdk.AccAddress) (kiratypes.OrderBook, error) {
+	orderBook, err := k.getCuratedOrderBook(ctx, id, curator)
+	if err != nil {
+		return kiratypes.OrderBook{}, err
+	}
+
+	orderBook.IsPaused = isPaused
+	k.SetOrderBook(ctx, orderBook)
+
+	return orderBook, nil
+}
+
+// SetOrderBookLimits sets the tick size and the minimum amount that new limit
+// orders of an order book must respect. Resting orders are left untouched.
+func (k Keeper) SetOrderBookLimits(ctx sdk.Context, id string, tickSize int64, minOrderAmount int64, curator sdk.AccAddress) (kiratypes.OrderBook, error) {
+	orderBook, err := k.getCuratedOrderBook(ctx, id, curator)
+	if err != nil {
+		return kiratypes.OrderBook{}, err
+	}
+
+	orderBook.TickSize = tickSize
+	orderBook.MinOrderAmount = minOrderAmount
+	k.SetOrderBook(ctx, orderBook)
+
+	return orderBook, nil
+}
+
+func (k Keeper) SetOrderBookMnemonic(ctx sdk.Context, id string, mnemonic string, curator sdk.AccAddress) (kiratypes.OrderBook, error) {
+	orderBook, err := k.getCuratedOrderBook(ctx, id, curator)
+	if err != nil {
+		return kiratypes.OrderBook{}, err
+	}
+
+	orderBook.Mnemonic = mnemonic
+	k.SetOrderBook(ctx, orderBook)
+
+	return orderBook, nil
+}
+
+func (k Keeper) TransferOrderBookCuratorship(ctx sdk.Context, id string, newCurator sdk.AccAddress, curator sdk.AccAddress) (kiratypes.OrderBook, error) {
+	orderBook, err := k.getCuratedOrderBook(ctx, id, curator)
+	if err != nil {
+		return kiratypes.OrderBook{}, err
+	}
+
+	return k.setOrderBookCurator(ctx, orderBook, newCurator)
+}
+
+// ReassignOrderBook hands an order book to a new curator on behalf of governance.
+func (k Keeper) ReassignOrderBook(ctx sdk.Context, id string, newCurator sdk.AccAddress) (kiratypes.OrderBook, error) {
+	orderBook, found := k.GetOrderBook(ctx, id)
+	if !found {
+		return kiratypes.OrderBook{}, sdkerrors.Wrap(types.ErrOrderBookNotFound, id)
+	}
+
+	return k.setOrderBookCurator(ctx, orderBook, newCurator)
+}
+
+// DelistOrderBook removes an order book on behalf of governance. The limit
+// orders resting on it are refunded, and its limit orders and trades are
+// deleted along with it.
+func (k Keeper) DelistOrderBook(ctx sdk.Context, id string) (kiratypes.OrderBook, error) {
+	orderBook, found := k.GetOrderBook(ctx, id)
+	if !found {
+		return kiratypes.OrderBook{}, sdkerrors.Wrap(types.ErrOrderBookNotFound, id)
+	}
+
+	for _, order := range k.GetLimitOrdersByOrderBook(ctx, orderBook.ID) {
+		k.refundLimitOrder(ctx, order)
+		k.DeleteLimitOrder(ctx, order)
+	}
+
+	k.deleteTrades(ctx, orderBook.ID)
+	k.DeleteOrderBook(ctx, orderBook)
+
+	return orderBook, nil
+}
+
+// getCuratedOrderBook returns an order book, making sure it is managed by curator.
+func (k Keeper) getCuratedOrderBook(ctx sdk.Context, id string, curator sdk.AccAddress) (kiratypes.OrderBook, error) {
+	orderBook, found := k.GetOrderBook(ctx, id)
+	if !found {
+		return kiratypes.OrderBook{}, sdkerrors.Wrap(types.ErrOrderBookNotFound, id)
+	}
+
+	if !orderBook.Curator.Equals(curator) {
+		return kiratypes.OrderBook{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the curator of an order book can manage it")
+	}
+
+	return orderBook, nil
+}
+
+// setOrderBookCurator hands an order book to a new curator. Module accounts
+// that can not receive funds can not be curators, since curators are paid a
+// share of the trading fees.
+func (k Keeper) setOrderBookCurator(ctx sdk.Context, orderBook kiratypes.OrderBook, newCurator sdk.AccAddress) (kiratypes.OrderBook, error) {
+	if k.bankKeeper.BlockedAddr(newCurator) {
+		return kiratypes.OrderBook{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", newCurator)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOrderBookByCuratorKey(orderBook.Curator, orderBook.ID))
+
+	orderBook.Curator = newCurator
+	k.SetOrderBook(ctx, orderBook)
+
+	return orderBook, nil
+}
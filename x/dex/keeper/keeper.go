@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	kiratypes "github.com/KiraCore/sekai/types"
+	"github.com/KiraCore/sekai/x/dex/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper represents the keeper that maintains the order books and their limit orders.
+type Keeper struct {
+	storeKey         sdk.StoreKey
+	cdc              codec.BinaryMarshaler
+	paramSpace       paramtypes.Subspace
+	bankKeeper       types.BankKeeper
+	feeCollectorName string // name of the module account receiving the trading fees
+}
+
+// NewKeeper returns new keeper.
+func NewKeeper(
+	storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, paramSpace paramtypes.Subspace, bankKeeper types.BankKeeper, feeCollectorName string,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:         storeKey,
+		cdc:              cdc,
+		paramSpace:       paramSpace,
+		bankKeeper:       bankKeeper,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// GetParams returns the total set of dex parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the dex parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// CreateOrderBook stores a new order book. Its Index is the next value of the
+// order book sequence and its ID is derived from the index and the pair, so
+// every validator assigns the same values.
+func (k Keeper) CreateOrderBook(ctx sdk.Context, base string, quote string, mnemonic string, curator sdk.AccAddress) kiratypes.OrderBook {
+	index := k.GetLastOrderBookIndex(ctx) + 1
+	k.SetLastOrderBookIndex(ctx, index)
+
+	orderBook := kiratypes.NewOrderBook()
+	orderBook.ID = orderBookID(index, base, quote)
+	orderBook.Index = index
+	orderBook.Base = base
+	orderBook.Quote = quote
+	orderBook.Mnemonic = mnemonic
+	orderBook.Curator = curator
+
+	k.SetOrderBook(ctx, orderBook)
+
+	return orderBook
+}
+
+func orderBookID(index uint32, base string, quote string) string {
+	return hex.EncodeToString(tmhash.SumTruncated([]byte(fmt.Sprintf("%d/%s/%s", index, base, quote))))
+}
+
+func (k Keeper) SetOrderBook(ctx sdk.Context, orderBook kiratypes.OrderBook) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&orderBook)
+	store.Set(types.GetOrderBookKey(orderBook.ID), bz)
+
+	// Save by pair and by curator
+	store.Set(types.GetOrderBookByPairKey(orderBook.Base, orderBook.Quote, orderBook.ID), []byte(orderBook.ID))
+	store.Set(types.GetOrderBookByCuratorKey(orderBook.Curator, orderBook.ID), []byte(orderBook.ID))
+}
+
+// DeleteOrderBook removes an order book and its pair and curator indexes.
+func (k Keeper) DeleteOrderBook(ctx sdk.Context, orderBook kiratypes.OrderBook) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOrderBookKey(orderBook.ID))
+	store.Delete(types.GetOrderBookByPairKey(orderBook.Base, orderBook.Quote, orderBook.ID))
+	store.Delete(types.GetOrderBookByCuratorKey(orderBook.Curator, orderBook.ID))
+}
+
+func (k Keeper) GetOrderBook(ctx sdk.Context, id string) (kiratypes.OrderBook, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOrderBookKey(id))
+	if bz == nil {
+		return kiratypes.OrderBook{}, false
+	}
+
+	var orderBook kiratypes.OrderBook
+	k.cdc.MustUnmarshalBinaryBare(bz, &orderBook)
+
+	return orderBook, true
+}
+
+func (k Keeper) GetOrderBooksByPair(ctx sdk.Context, base string, quote string) []kiratypes.OrderBook {
+	return k.getOrderBooksByIndex(ctx, types.GetOrderBooksByPairPrefix(base, quote))
+}
+
+func (k Keeper) GetOrderBooksByCurator(ctx sdk.Context, curator sdk.AccAddress) []kiratypes.OrderBook {
+	return k.getOrderBooksByIndex(ctx, types.GetOrderBooksByCuratorPrefix(curator))
+}
+
+// getOrderBooksByIndex resolves every order book ID stored under a secondary index prefix.
+func (k Keeper) getOrderBooksByIndex(ctx sdk.Context, prefix []byte) []kiratypes.OrderBook {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	var orderBooks []kiratypes.OrderBook
+	for ; iter.Valid(); iter.Next() {
+		orderBook, found := k.GetOrderBook(ctx, string(iter.Value()))
+		if !found {
+			panic(fmt.Sprintf("order book %s is indexed but not stored", iter.Value()))
+		}
+		orderBooks = append(orderBooks, orderBook)
+	}
+
+	return orderBooks
+}
+
+func (k Keeper) GetOrderBooks(ctx sdk.Context) []kiratypes.OrderBook {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.OrderBooksKey)
+	defer iter.Close()
+
+	var orderBooks []kiratypes.OrderBook
+	for ; iter.Valid(); iter.Next() {
+		var orderBook kiratypes.OrderBook
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &orderBook)
+		orderBooks = append(orderBooks, orderBook)
+	}
+
+	return orderBooks
+}
+
+func (k Keeper) GetLastOrderBookIndex(ctx sdk.Context) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastOrderBookIndexKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint32(bz)
+}
+
+func (k Keeper) SetLastOrderBookIndex(ctx sdk.Context, index uint32) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, index)
+	store.Set(types.LastOrderBookIndexKey, bz)
+}
@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestKeeper_CreateOrderBook(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	curator := sdk.AccAddress("curator_____________")
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "KEX", curator)
+	require.Equal(t, uint32(1), orderBook.Index)
+	require.Equal(t, uint32(1), app.DexKeeper.GetLastOrderBookIndex(ctx))
+
+	// Get by ID.
+	getOrderBook, found := app.DexKeeper.GetOrderBook(ctx, orderBook.ID)
+	require.True(t, found)
+	require.Equal(t, orderBook, getOrderBook)
+
+	// Get by pair, the pair is ordered.
+	require.Len(t, app.DexKeeper.GetOrderBooksByPair(ctx, "ukex", "ubtc"), 1)
+	require.Len(t, app.DexKeeper.GetOrderBooksByPair(ctx, "ubtc", "ukex"), 0)
+
+	// Get by curator.
+	require.Len(t, app.DexKeeper.GetOrderBooksByCurator(ctx, curator), 1)
+	require.Len(t, app.DexKeeper.GetOrderBooksByCurator(ctx, sdk.AccAddress("other_______________")), 0)
+
+	_, found = app.DexKeeper.GetOrderBook(ctx, "unknown")
+	require.False(t, found)
+}
+
+func TestKeeper_CreateOrderBook_IsDeterministic(t *testing.T) {
+	app1 := simapp.Setup(false)
+	ctx1 := app1.NewContext(false, tmproto.Header{})
+	app2 := simapp.Setup(false)
+	ctx2 := app2.NewContext(false, tmproto.Header{})
+
+	curator := sdk.AccAddress("curator_____________")
+
+	for i := 0; i < 3; i++ {
+		orderBook1 := app1.DexKeeper.CreateOrderBook(ctx1, "ukex", "ubtc", "", curator)
+		orderBook2 := app2.DexKeeper.CreateOrderBook(ctx2, "ukex", "ubtc", "", curator)
+		require.Equal(t, orderBook1, orderBook2)
+	}
+
+	require.Len(t, app1.DexKeeper.GetOrderBooks(ctx1), 3)
+}
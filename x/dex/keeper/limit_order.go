@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	kiratypes "github.com/KiraCore/sekai/types"
+	"github.com/KiraCore/sekai/x/dex/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateLimitOrder escrows the funds offered by a new limit order in the dex
+// module account and rests the order on its order book. An ExpiryTime of zero
+// means the order never expires. Market, IOC and FOK orders are executed right
+// away instead and returned with the amount they left unfilled, without being
+// stored.
+func (k Keeper) CreateLimitOrder(
+	ctx sdk.Context,
+	orderBookID string,
+	orderType uint8,
+	amount int64,
+	limitPrice int64,
+	expiryTime int64,
+	curator sdk.AccAddress,
+) (kiratypes.LimitOrder, error) {
+	orderBook, found := k.GetOrderBook(ctx, orderBookID)
+	if !found {
+		return kiratypes.LimitOrder{}, sdkerrors.Wrap(types.ErrOrderBookNotFound, orderBookID)
+	}
+
+	if orderBook.IsPaused {
+		return kiratypes.LimitOrder{}, sdkerrors.Wrap(types.ErrOrderBookPaused, orderBookID)
+	}
+
+	if orderBook.TickSize != 0 && limitPrice != 0 && limitPrice%orderBook.TickSize != 0 {
+		return kiratypes.LimitOrder{}, sdkerrors.Wrapf(types.ErrInvalidLimitPrice, "limit price must be a multiple of the tick size %d", orderBook.TickSize)
+	}
+
+	if amount < orderBook.MinOrderAmount {
+		return kiratypes.LimitOrder{}, sdkerrors.Wrapf(types.ErrInvalidAmount, "amount is below the minimum order amount %d", orderBook.MinOrderAmount)
+	}
+
+	if expiryTime != 0 && expiryTime <= ctx.BlockTime().Unix() {
+		return kiratypes.LimitOrder{}, sdkerrors.Wrap(types.ErrInvalidExpiryTime, "limit order already expired")
+	}
+
+	index := k.GetLastLimitOrderIndex(ctx) + 1
+
+	order := kiratypes.NewLimitOrder()
+	order.ID = limitOrderID(index, orderBookID)
+	order.Index = index
+	order.OrderBookID = orderBookID
+	order.OrderType = orderType
+	order.Amount = amount
+	order.LimitPrice = limitPrice
+	order.ExpiryTime = expiryTime
+	order.Curator = curator
+
+	if !types.IsLimitOrderType(orderType) {
+		order, err := k.executeImmediateOrder(ctx, orderBook, order)
+		if err != nil {
+			return kiratypes.LimitOrder{}, err
+		}
+
+		k.SetLastLimitOrderIndex(ctx, index)
+		return order, nil
+	}
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, curator, types.ModuleName, types.OfferedCoins(orderBook, order, amount))
+	if err != nil {
+		return kiratypes.LimitOrder{}, err
+	}
+
+	k.SetLastLimitOrderIndex(ctx, index)
+	k.SetLimitOrder(ctx, order)
+
+	return order, nil
+}
+
+// CancelLimitOrder refunds the escrowed funds of a resting limit order that
+// were not filled yet to its curator and deletes the order. It returns the
+// order as it was cancelled.
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, id string, curator sdk.AccAddress) (kiratypes.LimitOrder, error) {
+	order, found := k.GetLimitOrder(ctx, id)
+	if !found {
+		return kiratypes.LimitOrder{}, sdkerrors.Wrap(types.ErrLimitOrderNotFound, id)
+	}
+
+	if !order.Curator.Equals(curator) {
+		return kiratypes.LimitOrder{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the curator of a limit order can cancel it")
+	}
+
+	k.refundLimitOrder(ctx, order)
+	k.DeleteLimitOrder(ctx, order)
+
+	order.IsCancelled = true
+
+	return order, nil
+}
+
+// ExpireLimitOrders removes every resting limit order whose ExpiryTime is not
+// after the block time and refunds what was left of it to its curator. Only
+// the expired part of the expiry index is visited.
+func (k Keeper) ExpireLimitOrders(ctx sdk.Context) []kiratypes.LimitOrder {
+	store := ctx.KVStore(k.storeKey)
+
+	end := types.GetLimitOrdersByExpiryPrefix(ctx.BlockTime().Unix() + 1)
+	iter := store.Iterator(types.LimitOrdersByExpiryKey, end)
+
+	var expired []kiratypes.LimitOrder
+	for ; iter.Valid(); iter.Next() {
+		order, found := k.GetLimitOrder(ctx, string(iter.Value()))
+		if !found {
+			panic(fmt.Sprintf("limit order %s is indexed but not stored", iter.Value()))
+		}
+		expired = append(expired, order)
+	}
+	iter.Close()
+
+	for _, order := range expired {
+		k.refundLimitOrder(ctx, order)
+		k.DeleteLimitOrder(ctx, order)
+	}
+
+	return expired
+}
+
+// refundLimitOrder gives back to its curator the escrow backing what is left of a limit order.
+func (k Keeper) refundLimitOrder(ctx sdk.Context, order kiratypes.LimitOrder) {
+	orderBook, found := k.GetOrderBook(ctx, order.OrderBookID)
+	if !found {
+		panic(fmt.Sprintf("limit order %s rests on unknown order book %s", order.ID, order.OrderBookID))
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, order.Curator, types.OfferedCoins(orderBook, order, order.Amount))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func limitOrderID(index uint32, orderBookID string) string {
+	return hex.EncodeToString(tmhash.SumTruncated([]byte(fmt.Sprintf("%d/%s", index, orderBookID))))
+}
+
+// SetLimitOrder stores a limit order, resting it on its order book and, when
+// it can expire, in the expiry index.
+func (k Keeper) SetLimitOrder(ctx sdk.Context, order kiratypes.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&order)
+	store.Set(types.GetLimitOrderKey(order.ID), bz)
+
+	store.Set(types.GetLimitOrderByOrderBookKey(order.OrderBookID, order.OrderType, order.LimitPrice, order.Index), []byte(order.ID))
+	if order.ExpiryTime != 0 {
+		store.Set(types.GetLimitOrderByExpiryKey(order.ExpiryTime, order.ID), []byte(order.ID))
+	}
+}
+
+// DeleteLimitOrder removes a limit order and takes it off its order book.
+func (k Keeper) DeleteLimitOrder(ctx sdk.Context, order kiratypes.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLimitOrderKey(order.ID))
+	store.Delete(types.GetLimitOrderByOrderBookKey(order.OrderBookID, order.OrderType, order.LimitPrice, order.Index))
+	store.Delete(types.GetLimitOrderByExpiryKey(order.ExpiryTime, order.ID))
+}
+
+func (k Keeper) GetLimitOrder(ctx sdk.Context, id string) (kiratypes.LimitOrder, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLimitOrderKey(id))
+	if bz == nil {
+		return kiratypes.LimitOrder{}, false
+	}
+
+	var order kiratypes.LimitOrder
+	k.cdc.MustUnmarshalBinaryBare(bz, &order)
+
+	return order, true
+}
+
+// GetLimitOrdersByOrderBook returns the limit orders resting on an order book,
+// bids first and each side in price-time priority.
+func (k Keeper) GetLimitOrdersByOrderBook(ctx sdk.Context, orderBookID string) []kiratypes.LimitOrder {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetLimitOrdersByOrderBookPrefix(orderBookID))
+	defer iter.Close()
+
+	var orders []kiratypes.LimitOrder
+	for ; iter.Valid(); iter.Next() {
+		order, found := k.GetLimitOrder(ctx, string(iter.Value()))
+		if !found {
+			panic(fmt.Sprintf("limit order %s is indexed but not stored", iter.Value()))
+		}
+		orders = append(orders, order)
+	}
+
+	return orders
+}
+
+// GetLimitOrders returns every stored limit order.
+func (k Keeper) GetLimitOrders(ctx sdk.Context) []kiratypes.LimitOrder {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.LimitOrdersKey)
+	defer iter.Close()
+
+	var orders []kiratypes.LimitOrder
+	for ; iter.Valid(); iter.Next() {
+		var order kiratypes.LimitOrder
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &order)
+		orders = append(orders, order)
+	}
+
+	return orders
+}
+
+// getBestLimitOrder returns the limit order with the highest priority on one side of an order book.
+func (k Keeper) getBestLimitOrder(ctx sdk.Context, orderBookID string, orderType uint8) (kiratypes.LimitOrder, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetLimitOrdersBySidePrefix(orderBookID, orderType))
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return kiratypes.LimitOrder{}, false
+	}
+
+	order, found := k.GetLimitOrder(ctx, string(iter.Value()))
+	if !found {
+		panic(fmt.Sprintf("limit order %s is indexed but not stored", iter.Value()))
+	}
+
+	return order, true
+}
+
+func (k Keeper) GetLastLimitOrderIndex(ctx sdk.Context) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastLimitOrderIndexKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint32(bz)
+}
+
+func (k Keeper) SetLastLimitOrderIndex(ctx sdk.Context, index uint32) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, index)
+	store.Set(types.LastLimitOrderIndexKey, bz)
+}
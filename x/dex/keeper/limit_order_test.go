@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/dex/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestKeeper_CreateLimitOrder(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+	curator := addrs[0]
+	_, err := app.BankKeeper.AddCoins(ctx, curator, sdk.NewCoins(sdk.NewInt64Coin("ukex", 100)))
+	require.NoError(t, err)
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", curator)
+
+	order1, err := app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 60, 2, 0, curator)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), order1.Index)
+
+	// The second order does not have enough funds left, so the index is not consumed.
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 60, 2, 0, curator)
+	require.Error(t, err)
+	require.Equal(t, uint32(1), app.DexKeeper.GetLastLimitOrderIndex(ctx))
+
+	order2, err := app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 40, 2, 0, curator)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), order2.Index)
+	require.NotEqual(t, order1.ID, order2.ID)
+
+	getOrder, found := app.DexKeeper.GetLimitOrder(ctx, order2.ID)
+	require.True(t, found)
+	require.Equal(t, order2, getOrder)
+
+	require.Len(t, app.DexKeeper.GetLimitOrdersByOrderBook(ctx, orderBook.ID), 2)
+	require.True(t, app.BankKeeper.GetBalance(ctx, curator, "ukex").IsZero())
+}
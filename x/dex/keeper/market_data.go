@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/KiraCore/sekai/x/dex/types"
+)
+
+// GetDepth aggregates the limit orders resting on one side of an order book by
+// price level, in matching order. A limit of zero returns every price level.
+func (k Keeper) GetDepth(ctx sdk.Context, orderBookID string, orderType uint8, limit uint32) []types.PriceLevel {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetLimitOrdersBySidePrefix(orderBookID, orderType))
+	defer iter.Close()
+
+	var levels []types.PriceLevel
+	for ; iter.Valid(); iter.Next() {
+		order, found := k.GetLimitOrder(ctx, string(iter.Value()))
+		if !found {
+			panic(fmt.Sprintf("limit order %s is indexed but not stored", iter.Value()))
+		}
+
+		last := len(levels) - 1
+		if last >= 0 && levels[last].Price == order.LimitPrice {
+			levels[last].Amount += order.Amount
+			levels[last].Orders++
+			continue
+		}
+
+		if limit != 0 && len(levels) == int(limit) {
+			break
+		}
+
+		levels = append(levels, types.PriceLevel{Price: order.LimitPrice, Amount: order.Amount, Orders: 1})
+	}
+
+	return levels
+}
+
+// GetBestBidAsk returns the best bid and ask prices of an order book, and the
+// spread between them. A price is 0 when its side is empty, and so is the
+// spread unless both sides have orders.
+func (k Keeper) GetBestBidAsk(ctx sdk.Context, orderBookID string) (bestBid, bestAsk, spread int64) {
+	bid, bidFound := k.getBestLimitOrder(ctx, orderBookID, types.OrderTypeLimitBuy)
+	if bidFound {
+		bestBid = bid.LimitPrice
+	}
+
+	ask, askFound := k.getBestLimitOrder(ctx, orderBookID, types.OrderTypeLimitSell)
+	if askFound {
+		bestAsk = ask.LimitPrice
+	}
+
+	if bidFound && askFound {
+		spread = bestAsk - bestBid
+	}
+
+	return bestBid, bestAsk, spread
+}
+
+// recordTrade stores a fill in the trade history of its order book.
+func (k Keeper) recordTrade(ctx sdk.Context, orderBookID, buyOrderID, sellOrderID string, amount, price int64) {
+	index := k.GetLastTradeIndex(ctx) + 1
+	k.SetLastTradeIndex(ctx, index)
+
+	k.SetTrade(ctx, types.Trade{
+		Index:       index,
+		OrderBookID: orderBookID,
+		BuyOrderID:  buyOrderID,
+		SellOrderID: sellOrderID,
+		Amount:      amount,
+		Price:       price,
+		Time:        ctx.BlockTime().Unix(),
+	})
+}
+
+func (k Keeper) SetTrade(ctx sdk.Context, trade types.Trade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&trade)
+	store.Set(types.GetTradeKey(trade.OrderBookID, trade.Index), bz)
+}
+
+// GetTrades returns a page of the trades of an order book, most recent first.
+func (k Keeper) GetTrades(ctx sdk.Context, orderBookID string, pageReq *query.PageRequest) ([]types.Trade, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTradesByOrderBookPrefix(orderBookID))
+
+	var trades []types.Trade
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		var trade types.Trade
+		if err := k.cdc.UnmarshalBinaryBare(value, &trade); err != nil {
+			return err
+		}
+
+		trades = append(trades, trade)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return trades, pageRes, nil
+}
+
+// GetAllTrades returns the trades of every order book.
+func (k Keeper) GetAllTrades(ctx sdk.Context) []types.Trade {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.TradesKey)
+	defer iter.Close()
+
+	var trades []types.Trade
+	for ; iter.Valid(); iter.Next() {
+		var trade types.Trade
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &trade)
+		trades = append(trades, trade)
+	}
+
+	return trades
+}
+
+// PruneTrades removes the trades of an order book that are older than the
+// retention, in seconds. A retention of zero keeps every trade. Trades are
+// visited from the oldest one, so only the pruned part of the history is read.
+func (k Keeper) PruneTrades(ctx sdk.Context, orderBookID string, retention int64) {
+	if retention == 0 {
+		return
+	}
+
+	cutoff := ctx.BlockTime().Unix() - retention
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStoreReversePrefixIterator(store, types.GetTradesByOrderBookPrefix(orderBookID))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		var trade types.Trade
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &trade)
+		if trade.Time > cutoff {
+			break
+		}
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// deleteTrades removes the trade history of an order book.
+func (k Keeper) deleteTrades(ctx sdk.Context, orderBookID string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetTradesByOrderBookPrefix(orderBookID))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) GetLastTradeIndex(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTradeIndexKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetLastTradeIndex(ctx sdk.Context, index uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTradeIndexKey, sdk.Uint64ToBigEndian(index))
+}
@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	"fmt"
+
+	kiratypes "github.com/KiraCore/sekai/types"
+	"github.com/KiraCore/sekai/x/dex/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MatchOrderBook crosses the bids and asks resting on an order book until the
+// best bid is lower than the best ask. Orders are matched by price and then by
+// index, and each match fills the smaller order completely and the other one
+// partially. The order that was placed first sets the execution price. It
+// returns the error of the first fill that can not be paid out, and callers
+// discard what the matching changed.
+func (k Keeper) MatchOrderBook(ctx sdk.Context, orderBook kiratypes.OrderBook) error {
+	for {
+		bid, found := k.getBestLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitBuy)
+		if !found {
+			return nil
+		}
+
+		ask, found := k.getBestLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell)
+		if !found {
+			return nil
+		}
+
+		if bid.LimitPrice < ask.LimitPrice {
+			return nil
+		}
+
+		price := ask.LimitPrice
+		if bid.Index < ask.Index {
+			price = bid.LimitPrice
+		}
+
+		amount := bid.Amount
+		if ask.Amount < amount {
+			amount = ask.Amount
+		}
+
+		if err := k.executeFill(ctx, orderBook, bid, ask, amount, price); err != nil {
+			return err
+		}
+		k.reduceLimitOrder(ctx, bid, amount)
+		k.reduceLimitOrder(ctx, ask, amount)
+	}
+}
+
+// executeImmediateOrder matches a market, IOC or FOK order against the limit
+// orders resting on the other side of its order book, at their prices. The
+// order pays for each fill as it goes, so nothing is escrowed for the part
+// that is not filled and the order never rests on the book. It returns the
+// order with the amount left unfilled.
+func (k Keeper) executeImmediateOrder(ctx sdk.Context, orderBook kiratypes.OrderBook, order kiratypes.LimitOrder) (kiratypes.LimitOrder, error) {
+	isBuy := types.IsBuyOrderType(order.OrderType)
+	restingType := types.OrderTypeLimitBuy
+	if isBuy {
+		restingType = types.OrderTypeLimitSell
+	}
+
+	if types.IsFOKOrderType(order.OrderType) && k.getFillableAmount(ctx, order, restingType) < order.Amount {
+		return kiratypes.LimitOrder{}, sdkerrors.Wrap(types.ErrOrderNotFilled, "fill-or-kill order can not be filled completely")
+	}
+
+	for order.Amount > 0 {
+		maker, found := k.getBestLimitOrder(ctx, orderBook.ID, restingType)
+		if !found || !acceptsPrice(order, maker.LimitPrice) {
+			break
+		}
+
+		price := maker.LimitPrice
+		amount := order.Amount
+		if maker.Amount < amount {
+			amount = maker.Amount
+		}
+
+		taker := order
+		taker.LimitPrice = price
+		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, order.Curator, types.ModuleName, types.OfferedCoins(orderBook, taker, amount))
+		if err != nil {
+			return kiratypes.LimitOrder{}, err
+		}
+
+		bid, ask := maker, taker
+		if isBuy {
+			bid, ask = taker, maker
+		}
+		if err := k.executeFill(ctx, orderBook, bid, ask, amount, price); err != nil {
+			return kiratypes.LimitOrder{}, err
+		}
+		k.reduceLimitOrder(ctx, maker, amount)
+
+		order.Amount -= amount
+	}
+
+	return order, nil
+}
+
+// getFillableAmount returns how much of an immediate order the limit orders
+// resting on the other side of its order book can fill, capped to its amount.
+func (k Keeper) getFillableAmount(ctx sdk.Context, order kiratypes.LimitOrder, restingType uint8) int64 {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetLimitOrdersBySidePrefix(order.OrderBookID, restingType))
+	defer iter.Close()
+
+	var fillable int64
+	for ; iter.Valid() && fillable < order.Amount; iter.Next() {
+		maker, found := k.GetLimitOrder(ctx, string(iter.Value()))
+		if !found {
+			panic(fmt.Sprintf("limit order %s is indexed but not stored", iter.Value()))
+		}
+
+		if !acceptsPrice(order, maker.LimitPrice) {
+			break
+		}
+		fillable += maker.Amount
+	}
+
+	if fillable > order.Amount {
+		return order.Amount
+	}
+
+	return fillable
+}
+
+// acceptsPrice reports whether an immediate order can fill at price.
+func acceptsPrice(order kiratypes.LimitOrder, price int64) bool {
+	if types.IsMarketOrderType(order.OrderType) {
+		return true
+	}
+
+	if types.IsBuyOrderType(order.OrderType) {
+		return price <= order.LimitPrice
+	}
+
+	return price >= order.LimitPrice
+}
+
+// executeFill settles a fill between a bid and an ask, records the trade and
+// emits its event. Callers take the filled amount off the resting orders.
+func (k Keeper) executeFill(ctx sdk.Context, orderBook kiratypes.OrderBook, bid, ask kiratypes.LimitOrder, amount, price int64) error {
+	buyFee, sellFee, err := k.settleFill(ctx, orderBook, bid, ask, amount, price)
+	if err != nil {
+		return err
+	}
+
+	k.recordTrade(ctx, orderBook.ID, bid.ID, ask.ID, amount, price)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFill,
+			sdk.NewAttribute(types.AttributeKeyOrderBookID, orderBook.ID),
+			sdk.NewAttribute(types.AttributeKeyBuyOrderID, bid.ID),
+			sdk.NewAttribute(types.AttributeKeySellOrderID, ask.ID),
+			sdk.NewAttribute(types.AttributeKeyAmount, fmt.Sprintf("%d", amount)),
+			sdk.NewAttribute(types.AttributeKeyPrice, fmt.Sprintf("%d", price)),
+			sdk.NewAttribute(types.AttributeKeyBuyFee, buyFee.String()),
+			sdk.NewAttribute(types.AttributeKeySellFee, sellFee.String()),
+		),
+	)
+
+	return nil
+}
+
+// settleFill pays both sides of a fill out of the escrow: the buyer receives
+// the base and the seller the quote at the execution price, each less the fee
+// of its side. The maker is the order placed first. The buyer gets back the
+// quote it escrowed above the execution price. It returns the fees charged.
+func (k Keeper) settleFill(ctx sdk.Context, orderBook kiratypes.OrderBook, bid, ask kiratypes.LimitOrder, amount, price int64) (buyFee, sellFee sdk.Coin, err error) {
+	params := k.GetParams(ctx)
+
+	makerFeeRate, takerFeeRate := params.GetFeeRates(orderBook.ID)
+	buyFeeRate, sellFeeRate := takerFeeRate, makerFeeRate
+	if bid.Index < ask.Index {
+		buyFeeRate, sellFeeRate = makerFeeRate, takerFeeRate
+	}
+
+	base := sdk.NewInt(amount)
+	buyFee = sdk.NewCoin(orderBook.Base, base.ToDec().Mul(buyFeeRate).TruncateInt())
+	if err := k.payOut(ctx, bid.Curator, sdk.NewCoins(sdk.NewCoin(orderBook.Base, base.Sub(buyFee.Amount)))); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	quote := sdk.NewInt(amount).MulRaw(price)
+	sellFee = sdk.NewCoin(orderBook.Quote, quote.ToDec().Mul(sellFeeRate).TruncateInt())
+	if err := k.payOut(ctx, ask.Curator, sdk.NewCoins(sdk.NewCoin(orderBook.Quote, quote.Sub(sellFee.Amount)))); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if bid.LimitPrice > price {
+		if err := k.payOut(ctx, bid.Curator, sdk.NewCoins(sdk.NewCoin(orderBook.Quote, sdk.NewInt(amount).MulRaw(bid.LimitPrice-price)))); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	if err := k.distributeFees(ctx, orderBook, params.CuratorFeeShare, sdk.NewCoins(buyFee, sellFee)); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	return buyFee, sellFee, nil
+}
+
+// distributeFees pays the curator share of the fees to the curator of the
+// order book and the rest to the fee collector.
+func (k Keeper) distributeFees(ctx sdk.Context, orderBook kiratypes.OrderBook, curatorFeeShare sdk.Dec, fees sdk.Coins) error {
+	var shares []sdk.Coin
+	for _, fee := range fees {
+		shares = append(shares, sdk.NewCoin(fee.Denom, fee.Amount.ToDec().Mul(curatorFeeShare).TruncateInt()))
+	}
+	curatorFees := sdk.NewCoins(shares...)
+
+	if err := k.payOut(ctx, orderBook.Curator, curatorFees); err != nil {
+		return err
+	}
+
+	collectorFees := fees.Sub(curatorFees)
+	if collectorFees.Empty() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, collectorFees)
+}
+
+// payOut sends escrowed funds to an account. The escrow covers the payouts of
+// the matching, so a failure means the escrow is out of balance and is
+// returned rather than halting the chain.
+func (k Keeper) payOut(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error {
+	if coins.Empty() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+}
+
+// reduceLimitOrder removes a filled amount from a limit order, taking it off
+// the order book once it is completely filled.
+func (k Keeper) reduceLimitOrder(ctx sdk.Context, order kiratypes.LimitOrder, amount int64) {
+	order.Amount -= amount
+	if order.Amount == 0 {
+		k.DeleteLimitOrder(ctx, order)
+		return
+	}
+
+	k.SetLimitOrder(ctx, order)
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/dex/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestKeeper_ImmediateOrdersNeverRest(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	params := types.DefaultParams()
+	params.MakerFeeRate = sdk.ZeroDec()
+	params.TakerFeeRate = sdk.ZeroDec()
+	app.DexKeeper.SetParams(ctx, params)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+	seller, buyer := addrs[0], addrs[1]
+	_, err := app.BankKeeper.AddCoins(ctx, seller, sdk.NewCoins(sdk.NewInt64Coin("ukex", 100)))
+	require.NoError(t, err)
+	_, err = app.BankKeeper.AddCoins(ctx, buyer, sdk.NewCoins(sdk.NewInt64Coin("ubtc", 1000)))
+	require.NoError(t, err)
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", seller)
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 10, 5, 0, seller)
+	require.NoError(t, err)
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 10, 6, 0, seller)
+	require.NoError(t, err)
+
+	// A FOK order that can not fill completely fails without any fill.
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeFOKBuy, 25, 6, 0, buyer)
+	require.True(t, types.ErrOrderNotFilled.Is(err))
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeFOKBuy, 15, 5, 0, buyer)
+	require.True(t, types.ErrOrderNotFilled.Is(err))
+	require.Len(t, app.DexKeeper.GetLimitOrdersByOrderBook(ctx, orderBook.ID), 2)
+	require.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetBalance(ctx, buyer, "ubtc").Amount)
+
+	// An IOC order fills up to its limit price and drops the rest.
+	order, err := app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeIOCBuy, 15, 5, 0, buyer)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), order.Amount)
+	_, found := app.DexKeeper.GetLimitOrder(ctx, order.ID)
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(10), app.BankKeeper.GetBalance(ctx, buyer, "ukex").Amount)
+	require.Equal(t, sdk.NewInt(950), app.BankKeeper.GetBalance(ctx, buyer, "ubtc").Amount)
+
+	// A market order fills at any price and drops what the book can not fill.
+	order, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeMarketBuy, 15, 0, 0, buyer)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), order.Amount)
+	require.Empty(t, app.DexKeeper.GetLimitOrdersByOrderBook(ctx, orderBook.ID))
+	require.Equal(t, sdk.NewInt(20), app.BankKeeper.GetBalance(ctx, buyer, "ukex").Amount)
+	require.Equal(t, sdk.NewInt(890), app.BankKeeper.GetBalance(ctx, buyer, "ubtc").Amount)
+
+	// A FOK sell fills completely against the resting bids.
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitBuy, 10, 4, 0, buyer)
+	require.NoError(t, err)
+	order, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeFOKSell, 10, 4, 0, seller)
+	require.NoError(t, err)
+	require.Zero(t, order.Amount)
+	require.Empty(t, app.DexKeeper.GetLimitOrdersByOrderBook(ctx, orderBook.ID))
+	require.Equal(t, sdk.NewInt(110+40), app.BankKeeper.GetBalance(ctx, seller, "ubtc").Amount)
+
+	trades, _, err := app.DexKeeper.GetTrades(ctx, orderBook.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, trades, 3)
+}
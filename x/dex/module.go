@@ -0,0 +1,125 @@
+package dex
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/gogo/protobuf/grpc"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KiraCore/sekai/x/dex/client/cli"
+	"github.com/KiraCore/sekai/x/dex/keeper"
+	"github.com/KiraCore/sekai/x/dex/types"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+type AppModuleBasic struct{}
+
+func (b AppModuleBasic) RegisterCodec(amino *codec.LegacyAmino) {
+	types.RegisterCodec(amino)
+}
+
+func (b AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+func (b AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
+
+func (b AppModuleBasic) DefaultGenesis(marshaler codec.JSONMarshaler) json.RawMessage {
+	return marshaler.MustMarshalJSON(types.DefaultGenesis())
+}
+
+func (b AppModuleBasic) ValidateGenesis(marshaler codec.JSONMarshaler, config client.TxEncodingConfig, message json.RawMessage) error {
+	var genesisState types.GenesisState
+	if err := marshaler.UnmarshalJSON(message, &genesisState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return types.ValidateGenesis(genesisState)
+}
+
+func (b AppModuleBasic) RegisterRESTRoutes(context client.Context, router *mux.Router) {
+}
+
+func (b AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd()
+}
+
+func (b AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
+// AppModule implements the order book exchange.
+type AppModule struct {
+	AppModuleBasic
+	dexKeeper keeper.Keeper
+}
+
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState types.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesisState)
+
+	InitGenesis(ctx, am.dexKeeper, genesisState)
+
+	return nil
+}
+
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json.RawMessage {
+	genesisState := ExportGenesis(ctx, am.dexKeeper)
+	return cdc.MustMarshalJSON(genesisState)
+}
+
+func (am AppModule) RegisterInvariants(registry sdk.InvariantRegistry) {}
+
+func (am AppModule) QuerierRoute() string { return "" }
+
+func (am AppModule) LegacyQuerierHandler(marshaler codec.JSONMarshaler) sdk.Querier {
+	return nil
+}
+
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	BeginBlocker(ctx, am.dexKeeper)
+}
+
+func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.dexKeeper)
+	return nil
+}
+
+func (am AppModule) Name() string {
+	return types.ModuleName
+}
+
+// Route returns the message routing key for the dex module.
+func (am AppModule) Route() sdk.Route {
+	return sdk.NewRoute(types.RouterKey, NewHandler(am.dexKeeper))
+}
+
+// RegisterQueryService registers a GRPC query service to respond to the
+// module-specific GRPC queries.
+func (am AppModule) RegisterQueryService(server grpc.Server) {
+	querier := NewQuerier(am.dexKeeper)
+	types.RegisterQueryServer(server, querier)
+}
+
+// NewAppModule returns a new dex module.
+func NewAppModule(
+	keeper keeper.Keeper,
+) AppModule {
+	return AppModule{
+		dexKeeper: keeper,
+	}
+}
@@ -0,0 +1,58 @@
+package dex
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/KiraCore/sekai/x/dex/keeper"
+	"github.com/KiraCore/sekai/x/dex/types"
+)
+
+// NewOrderBookProposalHandler handles the governance proposals that delist or
+// reassign an order book regardless of its curator.
+func NewOrderBookProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.DelistOrderBookProposal:
+			return handleDelistOrderBookProposal(ctx, k, c)
+		case *types.ReassignOrderBookProposal:
+			return handleReassignOrderBookProposal(ctx, k, c)
+		default:
+			return errors.Wrapf(errors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+func handleDelistOrderBookProposal(ctx sdk.Context, k keeper.Keeper, p *types.DelistOrderBookProposal) error {
+	orderBook, err := k.DelistOrderBook(ctx, p.OrderBookID)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelistOrderBook,
+			sdk.NewAttribute(types.AttributeKeyOrderBookID, orderBook.ID),
+		),
+	)
+
+	return nil
+}
+
+func handleReassignOrderBookProposal(ctx sdk.Context, k keeper.Keeper, p *types.ReassignOrderBookProposal) error {
+	orderBook, err := k.ReassignOrderBook(ctx, p.OrderBookID, p.NewCurator)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReassignOrderBook,
+			sdk.NewAttribute(types.AttributeKeyOrderBookID, orderBook.ID),
+			sdk.NewAttribute(types.AttributeKeyNewCurator, orderBook.Curator.String()),
+		),
+	)
+
+	return nil
+}
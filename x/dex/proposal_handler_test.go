@@ -0,0 +1,90 @@
+package dex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	kiratypes "github.com/KiraCore/sekai/types"
+	"github.com/KiraCore/sekai/x/dex"
+	"github.com/KiraCore/sekai/x/dex/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestOrderBookProposalHandler_Reassign(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+	curator, newCurator := addrs[0], addrs[1]
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", curator)
+	handler := dex.NewOrderBookProposalHandler(app.DexKeeper)
+
+	err := handler(ctx, types.NewReassignOrderBookProposal("title", "description", "unknown", newCurator))
+	require.True(t, types.ErrOrderBookNotFound.Is(err))
+
+	// Module accounts that can not receive the curator fees can not curate.
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	err = handler(ctx, types.NewReassignOrderBookProposal("title", "description", orderBook.ID, feeCollector))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	err = handler(ctx, types.NewReassignOrderBookProposal("title", "description", orderBook.ID, newCurator))
+	require.NoError(t, err)
+
+	updated, found := app.DexKeeper.GetOrderBook(ctx, orderBook.ID)
+	require.True(t, found)
+	require.Equal(t, newCurator, updated.Curator)
+	require.Empty(t, app.DexKeeper.GetOrderBooksByCurator(ctx, curator))
+}
+
+func TestOrderBookProposalHandler_Delist(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+	trader := addrs[0]
+	_, err := app.BankKeeper.AddCoins(ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("ukex", 100), sdk.NewInt64Coin("ubtc", 100)))
+	require.NoError(t, err)
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", trader)
+	kept := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ueth", "", trader)
+
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitBuy, 10, 4, 0, trader)
+	require.NoError(t, err)
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 10, 4, 0, trader)
+	require.NoError(t, err)
+	dex.EndBlocker(ctx, app.DexKeeper)
+
+	resting, err := app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 20, 6, 0, trader)
+	require.NoError(t, err)
+	cancelled, err := app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitBuy, 5, 2, 0, trader)
+	require.NoError(t, err)
+	_, err = app.DexKeeper.CancelLimitOrder(ctx, cancelled.ID, trader)
+	require.NoError(t, err)
+
+	handler := dex.NewOrderBookProposalHandler(app.DexKeeper)
+	err = handler(ctx, types.NewDelistOrderBookProposal("title", "description", orderBook.ID))
+	require.NoError(t, err)
+
+	_, found := app.DexKeeper.GetOrderBook(ctx, orderBook.ID)
+	require.False(t, found)
+	require.Equal(t, []kiratypes.OrderBook{kept}, app.DexKeeper.GetOrderBooks(ctx))
+	require.Len(t, app.DexKeeper.GetOrderBooksByPair(ctx, "ukex", "ubtc"), 0)
+
+	_, found = app.DexKeeper.GetLimitOrder(ctx, resting.ID)
+	require.False(t, found)
+	require.Empty(t, app.DexKeeper.GetLimitOrders(ctx))
+
+	trades, _, err := app.DexKeeper.GetTrades(ctx, orderBook.ID, nil)
+	require.NoError(t, err)
+	require.Empty(t, trades)
+
+	// Every escrow went back to the trader.
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, trader, "ukex").Amount)
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, trader, "ubtc").Amount)
+}
@@ -0,0 +1,123 @@
+package dex
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/KiraCore/sekai/x/dex/keeper"
+	"github.com/KiraCore/sekai/x/dex/types"
+)
+
+type Querier struct {
+	keeper keeper.Keeper
+}
+
+func NewQuerier(keeper keeper.Keeper) types.QueryServer {
+	return &Querier{keeper: keeper}
+}
+
+func (q Querier) OrderBookByID(ctx context.Context, request *types.OrderBookByIDRequest) (*types.OrderBookResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	orderBook, found := q.keeper.GetOrderBook(c, request.ID)
+	if !found {
+		return nil, errors.Wrap(types.ErrOrderBookNotFound, request.ID)
+	}
+
+	return &types.OrderBookResponse{OrderBook: orderBook}, nil
+}
+
+func (q Querier) OrderBooksByPair(ctx context.Context, request *types.OrderBooksByPairRequest) (*types.OrderBooksResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	return &types.OrderBooksResponse{
+		OrderBooks: q.keeper.GetOrderBooksByPair(c, request.Base, request.Quote),
+	}, nil
+}
+
+func (q Querier) OrderBooksByCurator(ctx context.Context, request *types.OrderBooksByCuratorRequest) (*types.OrderBooksResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	return &types.OrderBooksResponse{
+		OrderBooks: q.keeper.GetOrderBooksByCurator(c, request.Curator),
+	}, nil
+}
+
+func (q Querier) LimitOrderByID(ctx context.Context, request *types.LimitOrderByIDRequest) (*types.LimitOrderResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	order, found := q.keeper.GetLimitOrder(c, request.ID)
+	if !found {
+		return nil, errors.Wrap(types.ErrLimitOrderNotFound, request.ID)
+	}
+
+	return &types.LimitOrderResponse{LimitOrder: order}, nil
+}
+
+func (q Querier) LimitOrdersByOrderBook(ctx context.Context, request *types.LimitOrdersByOrderBookRequest) (*types.LimitOrdersResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	return &types.LimitOrdersResponse{
+		LimitOrders: q.keeper.GetLimitOrdersByOrderBook(c, request.OrderBookID),
+	}, nil
+}
+
+func (q Querier) Depth(ctx context.Context, request *types.DepthRequest) (*types.DepthResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	if _, found := q.keeper.GetOrderBook(c, request.OrderBookID); !found {
+		return nil, errors.Wrap(types.ErrOrderBookNotFound, request.OrderBookID)
+	}
+
+	return &types.DepthResponse{
+		Bids: q.keeper.GetDepth(c, request.OrderBookID, types.OrderTypeLimitBuy, request.Limit),
+		Asks: q.keeper.GetDepth(c, request.OrderBookID, types.OrderTypeLimitSell, request.Limit),
+	}, nil
+}
+
+func (q Querier) BestBidAsk(ctx context.Context, request *types.BestBidAskRequest) (*types.BestBidAskResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	if _, found := q.keeper.GetOrderBook(c, request.OrderBookID); !found {
+		return nil, errors.Wrap(types.ErrOrderBookNotFound, request.OrderBookID)
+	}
+
+	bestBid, bestAsk, spread := q.keeper.GetBestBidAsk(c, request.OrderBookID)
+	return &types.BestBidAskResponse{BestBid: bestBid, BestAsk: bestAsk, Spread: spread}, nil
+}
+
+func (q Querier) Trades(ctx context.Context, request *types.TradesRequest) (*types.TradesResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	if _, found := q.keeper.GetOrderBook(c, request.OrderBookID); !found {
+		return nil, errors.Wrap(types.ErrOrderBookNotFound, request.OrderBookID)
+	}
+
+	trades, pageRes, err := q.keeper.GetTrades(c, request.OrderBookID, request.Pagination)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.TradesResponse{Trades: trades, Pagination: pageRes}, nil
+}
+
+func (q Querier) Params(ctx context.Context, request *types.ParamsRequest) (*types.ParamsResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	return &types.ParamsResponse{Params: q.keeper.GetParams(c)}, nil
+}
+
+func (q Querier) OrderBookFees(ctx context.Context, request *types.OrderBookFeesRequest) (*types.OrderBookFeesResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	if _, found := q.keeper.GetOrderBook(c, request.OrderBookID); !found {
+		return nil, errors.Wrap(types.ErrOrderBookNotFound, request.OrderBookID)
+	}
+
+	params := q.keeper.GetParams(c)
+	makerFeeRate, takerFeeRate := params.GetFeeRates(request.OrderBookID)
+
+	return &types.OrderBookFeesResponse{
+		MakerFeeRate:    makerFeeRate,
+		TakerFeeRate:    takerFeeRate,
+		CuratorFeeShare: params.CuratorFeeShare,
+	}, nil
+}
@@ -0,0 +1,142 @@
+package dex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/dex"
+	"github.com/KiraCore/sekai/x/dex/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestQuerier_OrderBooks(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+	curator1, curator2 := addrs[0], addrs[1]
+
+	orderBook1 := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", curator1)
+	orderBook2 := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ueth", "", curator1)
+	orderBook3 := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", curator2)
+
+	querier := dex.NewQuerier(app.DexKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	byID, err := querier.OrderBookByID(goCtx, &types.OrderBookByIDRequest{ID: orderBook2.ID})
+	require.NoError(t, err)
+	require.Equal(t, orderBook2, byID.OrderBook)
+
+	_, err = querier.OrderBookByID(goCtx, &types.OrderBookByIDRequest{ID: "unknown"})
+	require.True(t, types.ErrOrderBookNotFound.Is(err))
+
+	byPair, err := querier.OrderBooksByPair(goCtx, &types.OrderBooksByPairRequest{Base: "ukex", Quote: "ubtc"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []interface{}{orderBook1, orderBook3}, byPair.OrderBooks)
+
+	byCurator, err := querier.OrderBooksByCurator(goCtx, &types.OrderBooksByCuratorRequest{Curator: curator1})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []interface{}{orderBook1, orderBook2}, byCurator.OrderBooks)
+}
+
+func TestQuerier_MarketData(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+	trader := addrs[0]
+	_, err := app.BankKeeper.AddCoins(ctx, trader, sdk.NewCoins(sdk.NewInt64Coin("ukex", 100), sdk.NewInt64Coin("ubtc", 100)))
+	require.NoError(t, err)
+
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", trader)
+	for _, order := range []struct {
+		orderType     uint8
+		amount, price int64
+	}{
+		{types.OrderTypeLimitBuy, 10, 4},
+		{types.OrderTypeLimitBuy, 5, 4},
+		{types.OrderTypeLimitBuy, 7, 3},
+		{types.OrderTypeLimitSell, 8, 6},
+		{types.OrderTypeLimitSell, 2, 7},
+	} {
+		_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, order.orderType, order.amount, order.price, 0, trader)
+		require.NoError(t, err)
+	}
+
+	querier := dex.NewQuerier(app.DexKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	depth, err := querier.Depth(goCtx, &types.DepthRequest{OrderBookID: orderBook.ID})
+	require.NoError(t, err)
+	require.Equal(t, []types.PriceLevel{{Price: 4, Amount: 15, Orders: 2}, {Price: 3, Amount: 7, Orders: 1}}, depth.Bids)
+	require.Equal(t, []types.PriceLevel{{Price: 6, Amount: 8, Orders: 1}, {Price: 7, Amount: 2, Orders: 1}}, depth.Asks)
+
+	depth, err = querier.Depth(goCtx, &types.DepthRequest{OrderBookID: orderBook.ID, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, depth.Bids, 1)
+	require.Len(t, depth.Asks, 1)
+
+	best, err := querier.BestBidAsk(goCtx, &types.BestBidAskRequest{OrderBookID: orderBook.ID})
+	require.NoError(t, err)
+	require.Equal(t, &types.BestBidAskResponse{BestBid: 4, BestAsk: 6, Spread: 2}, best)
+
+	_, err = querier.BestBidAsk(goCtx, &types.BestBidAskRequest{OrderBookID: "unknown"})
+	require.True(t, types.ErrOrderBookNotFound.Is(err))
+
+	// Crosses the whole first bid and part of the second one.
+	_, err = app.DexKeeper.CreateLimitOrder(ctx, orderBook.ID, types.OrderTypeLimitSell, 12, 4, 0, trader)
+	require.NoError(t, err)
+	dex.EndBlocker(ctx, app.DexKeeper)
+
+	trades, err := querier.Trades(goCtx, &types.TradesRequest{OrderBookID: orderBook.ID, Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, trades.Trades, 1)
+	require.Equal(t, int64(2), trades.Trades[0].Amount)
+	require.NotNil(t, trades.Pagination.NextKey)
+
+	trades, err = querier.Trades(goCtx, &types.TradesRequest{OrderBookID: orderBook.ID, Pagination: &query.PageRequest{Key: trades.Pagination.NextKey, Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, trades.Trades, 1)
+	require.Equal(t, int64(10), trades.Trades[0].Amount)
+	require.Equal(t, int64(4), trades.Trades[0].Price)
+}
+
+func TestQuerier_OrderBookFees(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(10))
+	orderBook1 := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", addrs[0])
+	orderBook2 := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ueth", "", addrs[0])
+
+	params := types.DefaultParams()
+	params.OrderBookFees = []types.OrderBookFee{
+		{OrderBookID: orderBook2.ID, MakerFeeRate: sdk.ZeroDec(), TakerFeeRate: sdk.NewDecWithPrec(5, 3)},
+	}
+	app.DexKeeper.SetParams(ctx, params)
+
+	querier := dex.NewQuerier(app.DexKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	res, err := querier.Params(goCtx, &types.ParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+
+	fees, err := querier.OrderBookFees(goCtx, &types.OrderBookFeesRequest{OrderBookID: orderBook1.ID})
+	require.NoError(t, err)
+	require.Equal(t, params.MakerFeeRate, fees.MakerFeeRate)
+	require.Equal(t, params.TakerFeeRate, fees.TakerFeeRate)
+	require.Equal(t, params.CuratorFeeShare, fees.CuratorFeeShare)
+
+	fees, err = querier.OrderBookFees(goCtx, &types.OrderBookFeesRequest{OrderBookID: orderBook2.ID})
+	require.NoError(t, err)
+	require.True(t, fees.MakerFeeRate.IsZero())
+	require.Equal(t, sdk.NewDecWithPrec(5, 3), fees.TakerFeeRate)
+
+	_, err = querier.OrderBookFees(goCtx, &types.OrderBookFeesRequest{OrderBookID: "unknown"})
+	require.True(t, types.ErrOrderBookNotFound.Is(err))
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateOrderBook{}, "kiraHub/MsgCreateOrderBook", nil)
+	cdc.RegisterConcrete(&MsgCreateLimitOrder{}, "kiraHub/MsgCreateLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "kiraHub/MsgCancelLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgSetOrderBookPaused{}, "kiraHub/MsgSetOrderBookPaused", nil)
+	cdc.RegisterConcrete(&MsgSetOrderBookLimits{}, "kiraHub/MsgSetOrderBookLimits", nil)
+	cdc.RegisterConcrete(&MsgSetOrderBookMnemonic{}, "kiraHub/MsgSetOrderBookMnemonic", nil)
+	cdc.RegisterConcrete(&MsgTransferOrderBookCuratorship{}, "kiraHub/MsgTransferOrderBookCuratorship", nil)
+	cdc.RegisterConcrete(&DelistOrderBookProposal{}, "kiraHub/DelistOrderBookProposal", nil)
+	cdc.RegisterConcrete(&ReassignOrderBookProposal{}, "kiraHub/ReassignOrderBookProposal", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateOrderBook{},
+		&MsgCreateLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgSetOrderBookPaused{},
+		&MsgSetOrderBookLimits{},
+		&MsgSetOrderBookMnemonic{},
+		&MsgTransferOrderBookCuratorship{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&DelistOrderBookProposal{},
+		&ReassignOrderBookProposal{},
+	)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/dex module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/dex and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	amino.Seal()
+}
@@ -0,0 +1,2437 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex.proto
+
+package types
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MsgCreateOrderBook struct {
+	Base     string                                        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote    string                                        `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	Mnemonic string                                        `protobuf:"bytes,3,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Curator  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=curator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"curator,omitempty" yaml:"curator"`
+}
+
+func (m *MsgCreateOrderBook) Reset()         { *m = MsgCreateOrderBook{} }
+func (m *MsgCreateOrderBook) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateOrderBook) ProtoMessage()    {}
+func (*MsgCreateOrderBook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a721ae41f5e45b, []int{0}
+}
+func (m *MsgCreateOrderBook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateOrderBook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateOrderBook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateOrderBook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateOrderBook.Merge(m, src)
+}
+func (m *MsgCreateOrderBook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateOrderBook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateOrderBook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateOrderBook proto.InternalMessageInfo
+
+func (m *MsgCreateOrderBook) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *MsgCreateOrderBook) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *MsgCreateOrderBook) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *MsgCreateOrderBook) GetCurator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Curator
+	}
+	return nil
+}
+
+type MsgCreateLimitOrder struct {
+	OrderBookID string                                        `protobuf:"bytes,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	OrderType   uint8                                         `protobuf:"varint,2,opt,name=order_type,json=orderType,proto3,casttype=uint8" json:"order_type,omitempty"`
+	Amount      int64                                         `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	LimitPrice  int64                                         `protobuf:"varint,4,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	ExpiryTime  int64                                         `protobuf:"varint,5,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	Curator     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,6,opt,name=curator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"curator,omitempty" yaml:"curator"`
+}
+
+func (m *MsgCreateLimitOrder) Reset()         { *m = MsgCreateLimitOrder{} }
+func (m *MsgCreateLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLimitOrder) ProtoMessage()    {}
+func (*MsgCreateLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a721ae41f5e45b, []int{1}
+}
+func (m *MsgCreateLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLimitOrder.Merge(m, src)
+}
+func (m *MsgCreateLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateLimitOrder proto.InternalMessageInfo
+
+func (m *MsgCreateLimitOrder) GetOrderBookID() string {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return ""
+}
+
+func (m *MsgCreateLimitOrder) GetOrderType() uint8 {
+	if m != nil {
+		return m.OrderType
+	}
+	return 0
+}
+
+func (m *MsgCreateLimitOrder) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MsgCreateLimitOrder) GetLimitPrice() int64 {
+	if m != nil {
+		return m.LimitPrice
+	}
+	return 0
+}
+
+func (m *MsgCreateLimitOrder) GetExpiryTime() int64 {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return 0
+}
+
+func (m *MsgCreateLimitOrder) GetCurator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Curator
+	}
+	return nil
+}
+
+type MsgCancelLimitOrder struct {
+	LimitOrderID string                                        `protobuf:"bytes,1,opt,name=limit_order_id,json=limitOrderId,proto3" json:"limit_order_id,omitempty"`
+	Curator      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=curator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"curator,omitempty" yaml:"curator"`
+}
+
+func (m *MsgCancelLimitOrder) Reset()         { *m = MsgCancelLimitOrder{} }
+func (m *MsgCancelLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLimitOrder) ProtoMessage()    {}
+func (*MsgCancelLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a721ae41f5e45b, []int{2}
+}
+func (m *MsgCancelLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLimitOrder.Merge(m, src)
+}
+func (m *MsgCancelLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelLimitOrder proto.InternalMessageInfo
+
+func (m *MsgCancelLimitOrder) GetLimitOrderID() string {
+	if m != nil {
+		return m.LimitOrderID
+	}
+	return ""
+}
+
+func (m *MsgCancelLimitOrder) GetCurator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Curator
+	}
+	return nil
+}
+
+type MsgSetOrderBookPaused struct {
+	OrderBookID string                                        `protobuf:"bytes,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	IsPaused    bool                                          `protobuf:"varint,2,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	Curator     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=curator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"curator,omitempty" yaml:"curator"`
+}
+
+func (m *MsgSetOrderBookPaused) Reset()         { *m = MsgSetOrderBookPaused{} }
+func (m *MsgSetOrderBookPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOrderBookPaused) ProtoMessage()    {}
+func (*MsgSetOrderBookPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a721ae41f5e45b, []int{3}
+}
+func (m *MsgSetOrderBookPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOrderBookPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOrderBookPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOrderBookPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOrderBookPaused.Merge(m, src)
+}
+func (m *MsgSetOrderBookPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOrderBookPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOrderBookPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOrderBookPaused proto.InternalMessageInfo
+
+func (m *MsgSetOrderBookPaused) GetOrderBookID() string {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return ""
+}
+
+func (m *MsgSetOrderBookPaused) GetIsPaused() bool {
+	if m != nil {
+		return m.IsPaused
+	}
+	return false
+}
+
+func (m *MsgSetOrderBookPaused) GetCurator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Curator
+	}
+	return nil
+}
+
+type MsgSetOrderBookLimits struct {
+	OrderBookID    string                                        `protobuf:"bytes,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	TickSize       int64                                         `protobuf:"varint,2,opt,name=tick_size,json=tickSize,proto3" json:"tick_size,omitempty"`
+	MinOrderAmount int64                                         `protobuf:"varint,3,opt,name=min_order_amount,json=minOrderAmount,proto3" json:"min_order_amount,omitempty"`
+	Curator        github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=curator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"curator,omitempty" yaml:"curator"`
+}
+
+func (m *MsgSetOrderBookLimits) Reset()         { *m = MsgSetOrderBookLimits{} }
+func (m *MsgSetOrderBookLimits) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOrderBookLimits) ProtoMessage()    {}
+func (*MsgSetOrderBookLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a721ae41f5e45b, []int{4}
+}
+func (m *MsgSetOrderBookLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOrderBookLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOrderBookLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOrderBookLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOrderBookLimits.Merge(m, src)
+}
+func (m *MsgSetOrderBookLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOrderBookLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOrderBookLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOrderBookLimits proto.InternalMessageInfo
+
+func (m *MsgSetOrderBookLimits) GetOrderBookID() string {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return ""
+}
+
+func (m *MsgSetOrderBookLimits) GetTickSize() int64 {
+	if m != nil {
+		return m.TickSize
+	}
+	return 0
+}
+
+func (m *MsgSetOrderBookLimits) GetMinOrderAmount() int64 {
+	if m != nil {
+		return m.MinOrderAmount
+	}
+	return 0
+}
+
+func (m *MsgSetOrderBookLimits) GetCurator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Curator
+	}
+	return nil
+}
+
+type MsgSetOrderBookMnemonic struct {
+	OrderBookID string                                        `protobuf:"bytes,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	Mnemonic    string                                        `protobuf:"bytes,2,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Curator     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=curator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"curator,omitempty" yaml:"curator"`
+}
+
+func (m *MsgSetOrderBookMnemonic) Reset()         { *m = MsgSetOrderBookMnemonic{} }
+func (m *MsgSetOrderBookMnemonic) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOrderBookMnemonic) ProtoMessage()    {}
+func (*MsgSetOrderBookMnemonic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a721ae41f5e45b, []int{5}
+}
+func (m *MsgSetOrderBookMnemonic) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOrderBookMnemonic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOrderBookMnemonic.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOrderBookMnemonic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOrderBookMnemonic.Merge(m, src)
+}
+func (m *MsgSetOrderBookMnemonic) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOrderBookMnemonic) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOrderBookMnemonic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOrderBookMnemonic proto.InternalMessageInfo
+
+func (m *MsgSetOrderBookMnemonic) GetOrderBookID() string {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return ""
+}
+
+func (m *MsgSetOrderBookMnemonic) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *MsgSetOrderBookMnemonic) GetCurator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Curator
+	}
+	return nil
+}
+
+type MsgTransferOrderBookCuratorship struct {
+	OrderBookID string                                        `protobuf:"bytes,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	NewCurator  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=new_curator,json=newCurator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"new_curator,omitempty" yaml:"new_curator"`
+	Curator     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=curator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"curator,omitempty" yaml:"curator"`
+}
+
+func (m *MsgTransferOrderBookCuratorship) Reset()         { *m = MsgTransferOrderBookCuratorship{} }
+func (m *MsgTransferOrderBookCuratorship) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferOrderBookCuratorship) ProtoMessage()    {}
+func (*MsgTransferOrderBookCuratorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a721ae41f5e45b, []int{6}
+}
+func (m *MsgTransferOrderBookCuratorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferOrderBookCuratorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferOrderBookCuratorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferOrderBookCuratorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferOrderBookCuratorship.Merge(m, src)
+}
+func (m *MsgTransferOrderBookCuratorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferOrderBookCuratorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferOrderBookCuratorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferOrderBookCuratorship proto.InternalMessageInfo
+
+func (m *MsgTransferOrderBookCuratorship) GetOrderBookID() string {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return ""
+}
+
+func (m *MsgTransferOrderBookCuratorship) GetNewCurator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NewCurator
+	}
+	return nil
+}
+
+func (m *MsgTransferOrderBookCuratorship) GetCurator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Curator
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgCreateOrderBook)(nil), "kira.dex.MsgCreateOrderBook")
+	proto.RegisterType((*MsgCreateLimitOrder)(nil), "kira.dex.MsgCreateLimitOrder")
+	proto.RegisterType((*MsgCancelLimitOrder)(nil), "kira.dex.MsgCancelLimitOrder")
+	proto.RegisterType((*MsgSetOrderBookPaused)(nil), "kira.dex.MsgSetOrderBookPaused")
+	proto.RegisterType((*MsgSetOrderBookLimits)(nil), "kira.dex.MsgSetOrderBookLimits")
+	proto.RegisterType((*MsgSetOrderBookMnemonic)(nil), "kira.dex.MsgSetOrderBookMnemonic")
+	proto.RegisterType((*MsgTransferOrderBookCuratorship)(nil), "kira.dex.MsgTransferOrderBookCuratorship")
+}
+
+func init() { proto.RegisterFile("dex.proto", fileDescriptor_83a721ae41f5e45b) }
+
+var fileDescriptor_83a721ae41f5e45b = []byte{
+	// 609 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xeb, 0xa4, 0x2d, 0xc9, 0xa5, 0x2d, 0xd5, 0x51, 0x20, 0x2a, 0x92, 0x5d, 0x45, 0x42,
+	0xca, 0xd2, 0x78, 0xa8, 0x84, 0x50, 0x17, 0xd4, 0xa4, 0x12, 0xaa, 0x20, 0xa2, 0x72, 0x3b, 0x21,
+	0x21, 0xcb, 0xb1, 0x1f, 0xee, 0xc9, 0xb1, 0xcf, 0xdc, 0x9d, 0xd5, 0xa4, 0x7f, 0x05, 0x7f, 0x02,
+	0x0b, 0xff, 0x00, 0xfc, 0x01, 0xac, 0x4c, 0xd0, 0x91, 0xc9, 0x42, 0xc9, 0xc2, 0xdc, 0x09, 0x75,
+	0x42, 0xbe, 0x73, 0x5d, 0x17, 0xc4, 0x12, 0x29, 0x9d, 0x7c, 0xef, 0x87, 0x9f, 0xdf, 0xe7, 0xfb,
+	0xee, 0xce, 0xa8, 0xee, 0xc1, 0xa8, 0x13, 0x33, 0x2a, 0x28, 0xae, 0x05, 0x84, 0x39, 0x1d, 0x0f,
+	0x46, 0x9b, 0x1b, 0x3e, 0xf5, 0xa9, 0x74, 0x9a, 0xd9, 0x4a, 0xc5, 0x5b, 0x5f, 0x34, 0x84, 0xfb,
+	0xdc, 0xef, 0x31, 0x70, 0x04, 0xbc, 0x62, 0x1e, 0xb0, 0x2e, 0xa5, 0x01, 0xc6, 0x68, 0x71, 0xe0,
+	0x70, 0x68, 0x6a, 0x5b, 0x5a, 0xbb, 0x6e, 0xc9, 0x35, 0xde, 0x40, 0x4b, 0xef, 0x12, 0x2a, 0xa0,
+	0x59, 0x91, 0x4e, 0x65, 0xe0, 0x4d, 0x54, 0x0b, 0x23, 0x08, 0x69, 0x44, 0xdc, 0x66, 0x55, 0x06,
+	0x0a, 0x1b, 0xbf, 0x41, 0x77, 0xdc, 0x84, 0x39, 0x82, 0xb2, 0xe6, 0xe2, 0x96, 0xd6, 0x5e, 0xe9,
+	0xf6, 0x2e, 0x52, 0x63, 0x6d, 0xec, 0x84, 0xc3, 0xdd, 0x56, 0x1e, 0x68, 0x5d, 0xa6, 0xc6, 0xb6,
+	0x4f, 0xc4, 0x49, 0x32, 0xe8, 0xb8, 0x34, 0x34, 0x5d, 0xca, 0x43, 0xca, 0xf3, 0xc7, 0x36, 0xf7,
+	0x02, 0x53, 0x8c, 0x63, 0xe0, 0x9d, 0x3d, 0xd7, 0xdd, 0xf3, 0x3c, 0x06, 0x9c, 0x5b, 0x57, 0x35,
+	0x77, 0x17, 0x7f, 0x7d, 0x30, 0xb4, 0xd6, 0xe7, 0x0a, 0xba, 0x57, 0x10, 0xbc, 0x24, 0x21, 0x11,
+	0x12, 0x03, 0xef, 0xa0, 0x55, 0x9a, 0x2d, 0xec, 0x01, 0xa5, 0x81, 0x4d, 0x3c, 0xc5, 0xd2, 0xbd,
+	0x3b, 0x49, 0x8d, 0x46, 0x01, 0x7a, 0xb0, 0x6f, 0x35, 0x68, 0x61, 0x78, 0xb8, 0x8d, 0x90, 0x7a,
+	0x29, 0xfb, 0xaa, 0x04, 0x5d, 0xed, 0xd6, 0x2f, 0x53, 0x63, 0x29, 0x21, 0x91, 0x78, 0x6a, 0xd5,
+	0x65, 0xf0, 0x78, 0x1c, 0x03, 0x7e, 0x80, 0x96, 0x9d, 0x90, 0x26, 0x91, 0x90, 0xd4, 0x55, 0x2b,
+	0xb7, 0xb0, 0x81, 0x1a, 0xc3, 0xac, 0x09, 0x3b, 0x66, 0xc4, 0x05, 0xc9, 0x5d, 0xb5, 0x90, 0x74,
+	0x1d, 0x66, 0x9e, 0x2c, 0x01, 0x46, 0x31, 0x61, 0x63, 0x5b, 0x90, 0x10, 0x9a, 0x4b, 0x2a, 0x41,
+	0xb9, 0x8e, 0x49, 0x08, 0x65, 0xd5, 0x96, 0xe7, 0xa6, 0xda, 0x27, 0x4d, 0xa9, 0xe6, 0x44, 0x2e,
+	0x0c, 0x4b, 0xaa, 0x3d, 0x41, 0x6b, 0xaa, 0x7d, 0x25, 0x43, 0x21, 0xdb, 0xfa, 0x24, 0x35, 0x56,
+	0xae, 0xf3, 0x0e, 0xf6, 0xad, 0x95, 0xe1, 0xb5, 0xe5, 0x95, 0x9b, 0xae, 0xcc, 0xad, 0xe9, 0x6f,
+	0x1a, 0xba, 0xdf, 0xe7, 0xfe, 0x11, 0x88, 0x62, 0x80, 0x87, 0x4e, 0xc2, 0xc1, 0x9b, 0x6d, 0xd8,
+	0x8f, 0x50, 0x9d, 0x70, 0x3b, 0x96, 0x15, 0x64, 0xd7, 0x35, 0xab, 0x46, 0x78, 0x5e, 0xb1, 0x04,
+	0x54, 0x9d, 0x1b, 0xd0, 0xef, 0x7f, 0x81, 0xa4, 0xc6, 0x7c, 0x66, 0x20, 0x41, 0xdc, 0xc0, 0xe6,
+	0xe4, 0x4c, 0x6d, 0xde, 0xaa, 0x55, 0xcb, 0x1c, 0x47, 0xe4, 0x0c, 0x70, 0x1b, 0xad, 0x87, 0x24,
+	0xca, 0xe7, 0x7a, 0x63, 0xeb, 0xae, 0x85, 0x24, 0x92, 0x35, 0xf7, 0xd4, 0x16, 0xbe, 0x95, 0x63,
+	0xfb, 0x5d, 0x43, 0x0f, 0xff, 0x42, 0xef, 0x5f, 0xdd, 0x1b, 0x33, 0xc1, 0x97, 0x2f, 0xa2, 0xca,
+	0xff, 0x2f, 0xa2, 0xf9, 0x0d, 0xf3, 0x63, 0x05, 0x19, 0x7d, 0xee, 0x1f, 0x33, 0x27, 0xe2, 0x6f,
+	0x81, 0x15, 0x8d, 0xf6, 0x54, 0x16, 0x3f, 0x21, 0xf1, 0x6c, 0x64, 0x27, 0xa8, 0x11, 0xc1, 0xa9,
+	0x7d, 0xf3, 0x7c, 0x3d, 0xbf, 0x48, 0x0d, 0xac, 0x08, 0x4a, 0xc1, 0x19, 0x28, 0x50, 0x04, 0xa7,
+	0x79, 0x8b, 0xb7, 0xa2, 0x53, 0xf7, 0xd9, 0xd7, 0x89, 0xae, 0x9d, 0x4f, 0x74, 0xed, 0xe7, 0x44,
+	0xd7, 0xde, 0x4f, 0xf5, 0x85, 0xf3, 0xa9, 0xbe, 0xf0, 0x63, 0xaa, 0x2f, 0xbc, 0x7e, 0x5c, 0xaa,
+	0xfb, 0x82, 0x30, 0xa7, 0x47, 0x19, 0x98, 0x1c, 0x02, 0x87, 0x98, 0x23, 0xd3, 0x83, 0x91, 0x2a,
+	0x3d, 0x58, 0x96, 0xbf, 0xae, 0x9d, 0x3f, 0x03, 0x00, 0x3a, 0x80, 0x57, 0x6c, 0xe7, 0x06, 0x00,
+	0x00,
+}
+
+func (this *MsgCreateOrderBook) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCreateOrderBook)
+	if !ok {
+		that2, ok := that.(MsgCreateOrderBook)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Base != that1.Base {
+		return false
+	}
+	if this.Quote != that1.Quote {
+		return false
+	}
+	if this.Mnemonic != that1.Mnemonic {
+		return false
+	}
+	if !bytes.Equal(this.Curator, that1.Curator) {
+		return false
+	}
+	return true
+}
+func (this *MsgCreateLimitOrder) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCreateLimitOrder)
+	if !ok {
+		that2, ok := that.(MsgCreateLimitOrder)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OrderBookID != that1.OrderBookID {
+		return false
+	}
+	if this.OrderType != that1.OrderType {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if this.LimitPrice != that1.LimitPrice {
+		return false
+	}
+	if this.ExpiryTime != that1.ExpiryTime {
+		return false
+	}
+	if !bytes.Equal(this.Curator, that1.Curator) {
+		return false
+	}
+	return true
+}
+func (this *MsgCancelLimitOrder) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelLimitOrder)
+	if !ok {
+		that2, ok := that.(MsgCancelLimitOrder)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LimitOrderID != that1.LimitOrderID {
+		return false
+	}
+	if !bytes.Equal(this.Curator, that1.Curator) {
+		return false
+	}
+	return true
+}
+func (this *MsgSetOrderBookPaused) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetOrderBookPaused)
+	if !ok {
+		that2, ok := that.(MsgSetOrderBookPaused)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OrderBookID != that1.OrderBookID {
+		return false
+	}
+	if this.IsPaused != that1.IsPaused {
+		return false
+	}
+	if !bytes.Equal(this.Curator, that1.Curator) {
+		return false
+	}
+	return true
+}
+func (this *MsgSetOrderBookLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetOrderBookLimits)
+	if !ok {
+		that2, ok := that.(MsgSetOrderBookLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OrderBookID != that1.OrderBookID {
+		return false
+	}
+	if this.TickSize != that1.TickSize {
+		return false
+	}
+	if this.MinOrderAmount != that1.MinOrderAmount {
+		return false
+	}
+	if !bytes.Equal(this.Curator, that1.Curator) {
+		return false
+	}
+	return true
+}
+func (this *MsgSetOrderBookMnemonic) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetOrderBookMnemonic)
+	if !ok {
+		that2, ok := that.(MsgSetOrderBookMnemonic)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OrderBookID != that1.OrderBookID {
+		return false
+	}
+	if this.Mnemonic != that1.Mnemonic {
+		return false
+	}
+	if !bytes.Equal(this.Curator, that1.Curator) {
+		return false
+	}
+	return true
+}
+func (this *MsgTransferOrderBookCuratorship) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTransferOrderBookCuratorship)
+	if !ok {
+		that2, ok := that.(MsgTransferOrderBookCuratorship)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OrderBookID != that1.OrderBookID {
+		return false
+	}
+	if !bytes.Equal(this.NewCurator, that1.NewCurator) {
+		return false
+	}
+	if !bytes.Equal(this.Curator, that1.Curator) {
+		return false
+	}
+	return true
+}
+func (m *MsgCreateOrderBook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOrderBook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOrderBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Mnemonic) > 0 {
+		i -= len(m.Mnemonic)
+		copy(dAtA[i:], m.Mnemonic)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.Mnemonic)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpiryTime != 0 {
+		i = encodeVarintDex(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LimitPrice != 0 {
+		i = encodeVarintDex(dAtA, i, uint64(m.LimitPrice))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Amount != 0 {
+		i = encodeVarintDex(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintDex(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LimitOrderID) > 0 {
+		i -= len(m.LimitOrderID)
+		copy(dAtA[i:], m.LimitOrderID)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.LimitOrderID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOrderBookPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOrderBookPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOrderBookPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IsPaused {
+		i--
+		if m.IsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOrderBookLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOrderBookLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOrderBookLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MinOrderAmount != 0 {
+		i = encodeVarintDex(dAtA, i, uint64(m.MinOrderAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TickSize != 0 {
+		i = encodeVarintDex(dAtA, i, uint64(m.TickSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOrderBookMnemonic) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOrderBookMnemonic) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOrderBookMnemonic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Mnemonic) > 0 {
+		i -= len(m.Mnemonic)
+		copy(dAtA[i:], m.Mnemonic)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.Mnemonic)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferOrderBookCuratorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOrderBookCuratorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOrderBookCuratorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewCurator) > 0 {
+		i -= len(m.NewCurator)
+		copy(dAtA[i:], m.NewCurator)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.NewCurator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintDex(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDex(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDex(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateOrderBook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	l = len(m.Mnemonic)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovDex(uint64(m.OrderType))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovDex(uint64(m.Amount))
+	}
+	if m.LimitPrice != 0 {
+		n += 1 + sovDex(uint64(m.LimitPrice))
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovDex(uint64(m.ExpiryTime))
+	}
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LimitOrderID)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetOrderBookPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	if m.IsPaused {
+		n += 2
+	}
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetOrderBookLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	if m.TickSize != 0 {
+		n += 1 + sovDex(uint64(m.TickSize))
+	}
+	if m.MinOrderAmount != 0 {
+		n += 1 + sovDex(uint64(m.MinOrderAmount))
+	}
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetOrderBookMnemonic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	l = len(m.Mnemonic)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferOrderBookCuratorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	l = len(m.NewCurator)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	return n
+}
+
+func sovDex(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDex(x uint64) (n int) {
+	return sovDex(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateOrderBook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOrderBook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = append(m.Curator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Curator == nil {
+				m.Curator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= uint8(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitPrice", wireType)
+			}
+			m.LimitPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitPrice |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = append(m.Curator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Curator == nil {
+				m.Curator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = append(m.Curator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Curator == nil {
+				m.Curator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOrderBookPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOrderBookPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOrderBookPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPaused = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = append(m.Curator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Curator == nil {
+				m.Curator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOrderBookLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOrderBookLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOrderBookLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			m.TickSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderAmount", wireType)
+			}
+			m.MinOrderAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOrderAmount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = append(m.Curator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Curator == nil {
+				m.Curator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOrderBookMnemonic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOrderBookMnemonic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOrderBookMnemonic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = append(m.Curator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Curator == nil {
+				m.Curator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferOrderBookCuratorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferOrderBookCuratorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferOrderBookCuratorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCurator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewCurator = append(m.NewCurator[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewCurator == nil {
+				m.NewCurator = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = append(m.Curator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Curator == nil {
+				m.Curator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDex(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDex
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDex
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDex
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDex
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDex        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDex          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDex = fmt.Errorf("proto: unexpected end of group")
+)
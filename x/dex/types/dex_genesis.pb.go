@@ -0,0 +1,632 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex_genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/KiraCore/sekai/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the dex module's genesis state.
+type GenesisState struct {
+	Params     Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	OrderBooks []types.OrderBook `protobuf:"bytes,2,rep,name=order_books,json=orderBooks,proto3" json:"order_books" yaml:"order_books"`
+	// limit_orders are the limit orders resting on the order books.
+	LimitOrders         []types.LimitOrder `protobuf:"bytes,3,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders" yaml:"limit_orders"`
+	LastOrderBookIndex  uint32             `protobuf:"varint,4,opt,name=last_order_book_index,json=lastOrderBookIndex,proto3" json:"last_order_book_index,omitempty" yaml:"last_order_book_index"`
+	LastLimitOrderIndex uint32             `protobuf:"varint,5,opt,name=last_limit_order_index,json=lastLimitOrderIndex,proto3" json:"last_limit_order_index,omitempty" yaml:"last_limit_order_index"`
+	LastTradeIndex      uint64             `protobuf:"varint,6,opt,name=last_trade_index,json=lastTradeIndex,proto3" json:"last_trade_index,omitempty" yaml:"last_trade_index"`
+	// trades are the trade histories of the order books.
+	Trades []Trade `protobuf:"bytes,7,rep,name=trades,proto3" json:"trades"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf53953483139045, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetOrderBooks() []types.OrderBook {
+	if m != nil {
+		return m.OrderBooks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLimitOrders() []types.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastOrderBookIndex() uint32 {
+	if m != nil {
+		return m.LastOrderBookIndex
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastLimitOrderIndex() uint32 {
+	if m != nil {
+		return m.LastLimitOrderIndex
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastTradeIndex() uint64 {
+	if m != nil {
+		return m.LastTradeIndex
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTrades() []Trade {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "kira.dex.GenesisState")
+}
+
+func init() { proto.RegisterFile("dex_genesis.proto", fileDescriptor_bf53953483139045) }
+
+var fileDescriptor_bf53953483139045 = []byte{
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0x87, 0x37, 0x36, 0x46, 0x99, 0xac, 0x76, 0x9d, 0xad, 0x1a, 0xb6, 0x9a, 0xc4, 0x80, 0x90,
+	0x8b, 0x09, 0xd4, 0x9b, 0x17, 0x21, 0x22, 0x22, 0x0a, 0x96, 0xb4, 0x78, 0xf0, 0x12, 0x66, 0xcd,
+	0x10, 0x87, 0x24, 0x9d, 0x65, 0x66, 0x84, 0xf4, 0x23, 0x78, 0xf3, 0x63, 0xf5, 0xd8, 0xa3, 0xa7,
+	0x20, 0xbb, 0xdf, 0x20, 0x9f, 0x40, 0xe6, 0xcf, 0x36, 0x01, 0x7b, 0x9b, 0x7d, 0xde, 0xdf, 0xfb,
+	0xbc, 0xfb, 0xce, 0x04, 0x3c, 0x2a, 0x71, 0x57, 0x54, 0xf8, 0x02, 0x73, 0xc2, 0x93, 0x0d, 0xa3,
+	0x82, 0xc2, 0xfb, 0x35, 0x61, 0x28, 0x29, 0x71, 0xb7, 0x9a, 0x6f, 0x10, 0x43, 0xad, 0xe1, 0xab,
+	0x43, 0xca, 0x4a, 0xcc, 0xd6, 0x94, 0xd6, 0x06, 0x2c, 0x1a, 0xd2, 0x12, 0xa1, 0xa8, 0x21, 0xae,
+	0x60, 0xa8, 0xc4, 0xe6, 0xc7, 0x51, 0x45, 0x2b, 0xaa, 0x8e, 0xa9, 0x3c, 0x69, 0x1a, 0xfd, 0xb2,
+	0xc1, 0xfc, 0x83, 0x9e, 0x77, 0x26, 0x90, 0xc0, 0x30, 0x01, 0x8e, 0x1e, 0xe3, 0x59, 0xa1, 0x15,
+	0xbb, 0x27, 0x8b, 0x64, 0x3f, 0x3f, 0x39, 0x55, 0x3c, 0xb3, 0xaf, 0xfa, 0x60, 0x96, 0x9b, 0x14,
+	0x3c, 0x05, 0xae, 0x1a, 0x59, 0xc8, 0x7f, 0xc2, 0xbd, 0x3b, 0xe1, 0x41, 0xec, 0x9e, 0x2c, 0xc7,
+	0xa6, 0x2f, 0xb2, 0x98, 0x51, 0x5a, 0x67, 0x2b, 0xd9, 0x37, 0xf4, 0x01, 0xbc, 0x44, 0x6d, 0xf3,
+	0x26, 0x9a, 0x74, 0x45, 0x39, 0xa0, 0xfb, 0x18, 0x87, 0xe7, 0x60, 0xae, 0x36, 0x29, 0x14, 0xe3,
+	0xde, 0x81, 0x52, 0x1e, 0x8d, 0xca, 0xcf, 0xb2, 0xaa, 0xbd, 0xc7, 0xc6, 0xb9, 0xd4, 0xce, 0x69,
+	0x5f, 0x94, 0xbb, 0xcd, 0x4d, 0x90, 0xc3, 0x33, 0xf0, 0xb8, 0x41, 0x5c, 0x14, 0xe3, 0xd8, 0x82,
+	0x5c, 0x94, 0xb8, 0xf3, 0xec, 0xd0, 0x8a, 0x1f, 0x64, 0xe1, 0xd0, 0x07, 0xcf, 0x8c, 0xe4, 0xb6,
+	0x58, 0x94, 0x43, 0xc9, 0x6f, 0xb6, 0xf9, 0x28, 0x21, 0xfc, 0x0a, 0x9e, 0xa8, 0xf4, 0x64, 0xae,
+	0xb1, 0xde, 0x55, 0xd6, 0x17, 0x43, 0x1f, 0x3c, 0x9f, 0x58, 0xff, 0xcb, 0x45, 0xf9, 0x52, 0x16,
+	0xc6, 0x8d, 0xb4, 0xf7, 0x3d, 0x58, 0xa8, 0xbc, 0x7a, 0x3f, 0x63, 0x74, 0x42, 0x2b, 0xb6, 0xb3,
+	0xe3, 0xa1, 0x0f, 0x9e, 0x4e, 0x8c, 0x93, 0x44, 0x94, 0x3f, 0x94, 0xe8, 0x5c, 0x12, 0xad, 0x79,
+	0x05, 0x1c, 0x55, 0xe7, 0xde, 0x3d, 0x75, 0x87, 0x87, 0xe3, 0x1d, 0xaa, 0xd4, 0xfe, 0x29, 0x75,
+	0x28, 0x7b, 0x7b, 0xb5, 0xf5, 0xad, 0xeb, 0xad, 0x6f, 0xfd, 0xdd, 0xfa, 0xd6, 0xef, 0x9d, 0x3f,
+	0xbb, 0xde, 0xf9, 0xb3, 0x3f, 0x3b, 0x7f, 0xf6, 0xed, 0x65, 0x45, 0xc4, 0x8f, 0x9f, 0xeb, 0xe4,
+	0x3b, 0x6d, 0xd3, 0x4f, 0x84, 0xa1, 0x77, 0x94, 0xe1, 0x94, 0xe3, 0x1a, 0x91, 0xb4, 0x4b, 0x4b,
+	0xdc, 0xa5, 0xe2, 0x72, 0x83, 0xf9, 0xda, 0x51, 0xdf, 0xd4, 0xeb, 0x7f, 0x03, 0x00, 0xaa, 0x70,
+	0x3b, 0x30, 0xc6, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDexGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.LastTradeIndex != 0 {
+		i = encodeVarintDexGenesis(dAtA, i, uint64(m.LastTradeIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastLimitOrderIndex != 0 {
+		i = encodeVarintDexGenesis(dAtA, i, uint64(m.LastLimitOrderIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastOrderBookIndex != 0 {
+		i = encodeVarintDexGenesis(dAtA, i, uint64(m.LastOrderBookIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDexGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.OrderBooks) > 0 {
+		for iNdEx := len(m.OrderBooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDexGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDexGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDexGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDexGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovDexGenesis(uint64(l))
+	if len(m.OrderBooks) > 0 {
+		for _, e := range m.OrderBooks {
+			l = e.Size()
+			n += 1 + l + sovDexGenesis(uint64(l))
+		}
+	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovDexGenesis(uint64(l))
+		}
+	}
+	if m.LastOrderBookIndex != 0 {
+		n += 1 + sovDexGenesis(uint64(m.LastOrderBookIndex))
+	}
+	if m.LastLimitOrderIndex != 0 {
+		n += 1 + sovDexGenesis(uint64(m.LastLimitOrderIndex))
+	}
+	if m.LastTradeIndex != 0 {
+		n += 1 + sovDexGenesis(uint64(m.LastTradeIndex))
+	}
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovDexGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDexGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDexGenesis(x uint64) (n int) {
+	return sovDexGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDexGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDexGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBooks = append(m.OrderBooks, types.OrderBook{})
+			if err := m.OrderBooks[len(m.OrderBooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDexGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, types.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOrderBookIndex", wireType)
+			}
+			m.LastOrderBookIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOrderBookIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastLimitOrderIndex", wireType)
+			}
+			m.LastLimitOrderIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastLimitOrderIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTradeIndex", wireType)
+			}
+			m.LastTradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDexGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDexGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDexGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDexGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDexGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDexGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDexGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDexGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDexGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDexGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDexGenesis = fmt.Errorf("proto: unexpected end of group")
+)
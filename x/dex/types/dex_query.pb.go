@@ -0,0 +1,4334 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex_query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/KiraCore/sekai/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type OrderBookByIDRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *OrderBookByIDRequest) Reset()         { *m = OrderBookByIDRequest{} }
+func (m *OrderBookByIDRequest) String() string { return proto.CompactTextString(m) }
+func (*OrderBookByIDRequest) ProtoMessage()    {}
+func (*OrderBookByIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{0}
+}
+func (m *OrderBookByIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookByIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookByIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookByIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookByIDRequest.Merge(m, src)
+}
+func (m *OrderBookByIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookByIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookByIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookByIDRequest proto.InternalMessageInfo
+
+func (m *OrderBookByIDRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+type OrderBooksByPairRequest struct {
+	Base  string `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (m *OrderBooksByPairRequest) Reset()         { *m = OrderBooksByPairRequest{} }
+func (m *OrderBooksByPairRequest) String() string { return proto.CompactTextString(m) }
+func (*OrderBooksByPairRequest) ProtoMessage()    {}
+func (*OrderBooksByPairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{1}
+}
+func (m *OrderBooksByPairRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBooksByPairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBooksByPairRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBooksByPairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBooksByPairRequest.Merge(m, src)
+}
+func (m *OrderBooksByPairRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBooksByPairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBooksByPairRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBooksByPairRequest proto.InternalMessageInfo
+
+func (m *OrderBooksByPairRequest) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *OrderBooksByPairRequest) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+type OrderBooksByCuratorRequest struct {
+	Curator github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=curator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"curator,omitempty" yaml:"curator"`
+}
+
+func (m *OrderBooksByCuratorRequest) Reset()         { *m = OrderBooksByCuratorRequest{} }
+func (m *OrderBooksByCuratorRequest) String() string { return proto.CompactTextString(m) }
+func (*OrderBooksByCuratorRequest) ProtoMessage()    {}
+func (*OrderBooksByCuratorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{2}
+}
+func (m *OrderBooksByCuratorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBooksByCuratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBooksByCuratorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBooksByCuratorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBooksByCuratorRequest.Merge(m, src)
+}
+func (m *OrderBooksByCuratorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBooksByCuratorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBooksByCuratorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBooksByCuratorRequest proto.InternalMessageInfo
+
+func (m *OrderBooksByCuratorRequest) GetCurator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Curator
+	}
+	return nil
+}
+
+type OrderBookResponse struct {
+	OrderBook types.OrderBook `protobuf:"bytes,1,opt,name=order_book,json=orderBook,proto3" json:"order_book"`
+}
+
+func (m *OrderBookResponse) Reset()         { *m = OrderBookResponse{} }
+func (m *OrderBookResponse) String() string { return proto.CompactTextString(m) }
+func (*OrderBookResponse) ProtoMessage()    {}
+func (*OrderBookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{3}
+}
+func (m *OrderBookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookResponse.Merge(m, src)
+}
+func (m *OrderBookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookResponse proto.InternalMessageInfo
+
+func (m *OrderBookResponse) GetOrderBook() types.OrderBook {
+	if m != nil {
+		return m.OrderBook
+	}
+	return types.OrderBook{}
+}
+
+type OrderBooksResponse struct {
+	OrderBooks []types.OrderBook `protobuf:"bytes,1,rep,name=order_books,json=orderBooks,proto3" json:"order_books"`
+}
+
+func (m *OrderBooksResponse) Reset()         { *m = OrderBooksResponse{} }
+func (m *OrderBooksResponse) String() string { return proto.CompactTextString(m) }
+func (*OrderBooksResponse) ProtoMessage()    {}
+func (*OrderBooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{4}
+}
+func (m *OrderBooksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBooksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBooksResponse.Merge(m, src)
+}
+func (m *OrderBooksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBooksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBooksResponse proto.InternalMessageInfo
+
+func (m *OrderBooksResponse) GetOrderBooks() []types.OrderBook {
+	if m != nil {
+		return m.OrderBooks
+	}
+	return nil
+}
+
+type LimitOrderByIDRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *LimitOrderByIDRequest) Reset()         { *m = LimitOrderByIDRequest{} }
+func (m *LimitOrderByIDRequest) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderByIDRequest) ProtoMessage()    {}
+func (*LimitOrderByIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{5}
+}
+func (m *LimitOrderByIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderByIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderByIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrderByIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderByIDRequest.Merge(m, src)
+}
+func (m *LimitOrderByIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderByIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderByIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrderByIDRequest proto.InternalMessageInfo
+
+func (m *LimitOrderByIDRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+type LimitOrdersByOrderBookRequest struct {
+	OrderBookID string `protobuf:"bytes,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+}
+
+func (m *LimitOrdersByOrderBookRequest) Reset()         { *m = LimitOrdersByOrderBookRequest{} }
+func (m *LimitOrdersByOrderBookRequest) String() string { return proto.CompactTextString(m) }
+func (*LimitOrdersByOrderBookRequest) ProtoMessage()    {}
+func (*LimitOrdersByOrderBookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{6}
+}
+func (m *LimitOrdersByOrderBookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrdersByOrderBookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrdersByOrderBookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrdersByOrderBookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrdersByOrderBookRequest.Merge(m, src)
+}
+func (m *LimitOrdersByOrderBookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrdersByOrderBookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrdersByOrderBookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrdersByOrderBookRequest proto.InternalMessageInfo
+
+func (m *LimitOrdersByOrderBookRequest) GetOrderBookID() string {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return ""
+}
+
+type LimitOrderResponse struct {
+	LimitOrder types.LimitOrder `protobuf:"bytes,1,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order"`
+}
+
+func (m *LimitOrderResponse) Reset()         { *m = LimitOrderResponse{} }
+func (m *LimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderResponse) ProtoMessage()    {}
+func (*LimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{7}
+}
+func (m *LimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderResponse.Merge(m, src)
+}
+func (m *LimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrderResponse proto.InternalMessageInfo
+
+func (m *LimitOrderResponse) GetLimitOrder() types.LimitOrder {
+	if m != nil {
+		return m.LimitOrder
+	}
+	return types.LimitOrder{}
+}
+
+type LimitOrdersResponse struct {
+	LimitOrders []types.LimitOrder `protobuf:"bytes,1,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
+}
+
+func (m *LimitOrdersResponse) Reset()         { *m = LimitOrdersResponse{} }
+func (m *LimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*LimitOrdersResponse) ProtoMessage()    {}
+func (*LimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{8}
+}
+func (m *LimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrdersResponse.Merge(m, src)
+}
+func (m *LimitOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrdersResponse proto.InternalMessageInfo
+
+func (m *LimitOrdersResponse) GetLimitOrders() []types.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+type DepthRequest struct {
+	OrderBookID string `protobuf:"bytes,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// limit caps the number of price levels returned for each side, 0 returns all of them.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *DepthRequest) Reset()         { *m = DepthRequest{} }
+func (m *DepthRequest) String() string { return proto.CompactTextString(m) }
+func (*DepthRequest) ProtoMessage()    {}
+func (*DepthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{9}
+}
+func (m *DepthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepthRequest.Merge(m, src)
+}
+func (m *DepthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepthRequest proto.InternalMessageInfo
+
+func (m *DepthRequest) GetOrderBookID() string {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return ""
+}
+
+func (m *DepthRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type DepthResponse struct {
+	// bids are sorted from the highest price and asks from the lowest one.
+	Bids []PriceLevel `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids"`
+	Asks []PriceLevel `protobuf:"bytes,2,rep,name=asks,proto3" json:"asks"`
+}
+
+func (m *DepthResponse) Reset()         { *m = DepthResponse{} }
+func (m *DepthResponse) String() string { return proto.CompactTextString(m) }
+func (*DepthResponse) ProtoMessage()    {}
+func (*DepthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{10}
+}
+func (m *DepthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepthResponse.Merge(m, src)
+}
+func (m *DepthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepthResponse proto.InternalMessageInfo
+
+func (m *DepthResponse) GetBids() []PriceLevel {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *DepthResponse) GetAsks() []PriceLevel {
+	if m != nil {
+		return m.Asks
+	}
+	return nil
+}
+
+type BestBidAskRequest struct {
+	OrderBookID string `protobuf:"bytes,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+}
+
+func (m *BestBidAskRequest) Reset()         { *m = BestBidAskRequest{} }
+func (m *BestBidAskRequest) String() string { return proto.CompactTextString(m) }
+func (*BestBidAskRequest) ProtoMessage()    {}
+func (*BestBidAskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{11}
+}
+func (m *BestBidAskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BestBidAskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BestBidAskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BestBidAskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BestBidAskRequest.Merge(m, src)
+}
+func (m *BestBidAskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BestBidAskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BestBidAskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BestBidAskRequest proto.InternalMessageInfo
+
+func (m *BestBidAskRequest) GetOrderBookID() string {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return ""
+}
+
+// BestBidAskResponse leaves a price at 0 when its side of the order book is
+// empty, and the spread at 0 unless both sides have orders.
+type BestBidAskResponse struct {
+	BestBid int64 `protobuf:"varint,1,opt,name=best_bid,json=bestBid,proto3" json:"best_bid,omitempty"`
+	BestAsk int64 `protobuf:"varint,2,opt,name=best_ask,json=bestAsk,proto3" json:"best_ask,omitempty"`
+	Spread  int64 `protobuf:"varint,3,opt,name=spread,proto3" json:"spread,omitempty"`
+}
+
+func (m *BestBidAskResponse) Reset()         { *m = BestBidAskResponse{} }
+func (m *BestBidAskResponse) String() string { return proto.CompactTextString(m) }
+func (*BestBidAskResponse) ProtoMessage()    {}
+func (*BestBidAskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{12}
+}
+func (m *BestBidAskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BestBidAskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BestBidAskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BestBidAskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BestBidAskResponse.Merge(m, src)
+}
+func (m *BestBidAskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BestBidAskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BestBidAskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BestBidAskResponse proto.InternalMessageInfo
+
+func (m *BestBidAskResponse) GetBestBid() int64 {
+	if m != nil {
+		return m.BestBid
+	}
+	return 0
+}
+
+func (m *BestBidAskResponse) GetBestAsk() int64 {
+	if m != nil {
+		return m.BestAsk
+	}
+	return 0
+}
+
+func (m *BestBidAskResponse) GetSpread() int64 {
+	if m != nil {
+		return m.Spread
+	}
+	return 0
+}
+
+type TradesRequest struct {
+	OrderBookID string             `protobuf:"bytes,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *TradesRequest) Reset()         { *m = TradesRequest{} }
+func (m *TradesRequest) String() string { return proto.CompactTextString(m) }
+func (*TradesRequest) ProtoMessage()    {}
+func (*TradesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{13}
+}
+func (m *TradesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradesRequest.Merge(m, src)
+}
+func (m *TradesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradesRequest proto.InternalMessageInfo
+
+func (m *TradesRequest) GetOrderBookID() string {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return ""
+}
+
+func (m *TradesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type TradesResponse struct {
+	Trades     []Trade             `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *TradesResponse) Reset()         { *m = TradesResponse{} }
+func (m *TradesResponse) String() string { return proto.CompactTextString(m) }
+func (*TradesResponse) ProtoMessage()    {}
+func (*TradesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{14}
+}
+func (m *TradesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradesResponse.Merge(m, src)
+}
+func (m *TradesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradesResponse proto.InternalMessageInfo
+
+func (m *TradesResponse) GetTrades() []Trade {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func (m *TradesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type ParamsRequest struct {
+}
+
+func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
+func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ParamsRequest) ProtoMessage()    {}
+func (*ParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{15}
+}
+func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRequest.Merge(m, src)
+}
+func (m *ParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
+
+type ParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
+func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ParamsResponse) ProtoMessage()    {}
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{16}
+}
+func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsResponse.Merge(m, src)
+}
+func (m *ParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+
+func (m *ParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type OrderBookFeesRequest struct {
+	OrderBookID string `protobuf:"bytes,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+}
+
+func (m *OrderBookFeesRequest) Reset()         { *m = OrderBookFeesRequest{} }
+func (m *OrderBookFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*OrderBookFeesRequest) ProtoMessage()    {}
+func (*OrderBookFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{17}
+}
+func (m *OrderBookFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookFeesRequest.Merge(m, src)
+}
+func (m *OrderBookFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookFeesRequest proto.InternalMessageInfo
+
+func (m *OrderBookFeesRequest) GetOrderBookID() string {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return ""
+}
+
+type OrderBookFeesResponse struct {
+	MakerFeeRate    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
+	TakerFeeRate    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
+	CuratorFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=curator_fee_share,json=curatorFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"curator_fee_share"`
+}
+
+func (m *OrderBookFeesResponse) Reset()         { *m = OrderBookFeesResponse{} }
+func (m *OrderBookFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*OrderBookFeesResponse) ProtoMessage()    {}
+func (*OrderBookFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63dc850716c1da1d, []int{18}
+}
+func (m *OrderBookFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookFeesResponse.Merge(m, src)
+}
+func (m *OrderBookFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookFeesResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*OrderBookByIDRequest)(nil), "kira.dex.OrderBookByIDRequest")
+	proto.RegisterType((*OrderBooksByPairRequest)(nil), "kira.dex.OrderBooksByPairRequest")
+	proto.RegisterType((*OrderBooksByCuratorRequest)(nil), "kira.dex.OrderBooksByCuratorRequest")
+	proto.RegisterType((*OrderBookResponse)(nil), "kira.dex.OrderBookResponse")
+	proto.RegisterType((*OrderBooksResponse)(nil), "kira.dex.OrderBooksResponse")
+	proto.RegisterType((*LimitOrderByIDRequest)(nil), "kira.dex.LimitOrderByIDRequest")
+	proto.RegisterType((*LimitOrdersByOrderBookRequest)(nil), "kira.dex.LimitOrdersByOrderBookRequest")
+	proto.RegisterType((*LimitOrderResponse)(nil), "kira.dex.LimitOrderResponse")
+	proto.RegisterType((*LimitOrdersResponse)(nil), "kira.dex.LimitOrdersResponse")
+	proto.RegisterType((*DepthRequest)(nil), "kira.dex.DepthRequest")
+	proto.RegisterType((*DepthResponse)(nil), "kira.dex.DepthResponse")
+	proto.RegisterType((*BestBidAskRequest)(nil), "kira.dex.BestBidAskRequest")
+	proto.RegisterType((*BestBidAskResponse)(nil), "kira.dex.BestBidAskResponse")
+	proto.RegisterType((*TradesRequest)(nil), "kira.dex.TradesRequest")
+	proto.RegisterType((*TradesResponse)(nil), "kira.dex.TradesResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "kira.dex.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "kira.dex.ParamsResponse")
+	proto.RegisterType((*OrderBookFeesRequest)(nil), "kira.dex.OrderBookFeesRequest")
+	proto.RegisterType((*OrderBookFeesResponse)(nil), "kira.dex.OrderBookFeesResponse")
+}
+
+func init() { proto.RegisterFile("dex_query.proto", fileDescriptor_63dc850716c1da1d) }
+
+var fileDescriptor_63dc850716c1da1d = []byte{
+	// 997 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0x8f, 0x9d, 0xc4, 0x6d, 0x9f, 0xff, 0x25, 0x93, 0x34, 0x31, 0x4b, 0x6a, 0x97, 0x11, 0xb4,
+	0x08, 0x29, 0xbb, 0x6a, 0x7a, 0x41, 0x41, 0x15, 0x64, 0x63, 0x05, 0xac, 0x16, 0x70, 0x96, 0x70,
+	0xa0, 0x08, 0x59, 0xb3, 0xde, 0xc1, 0x59, 0x6d, 0x9c, 0x71, 0x76, 0xd6, 0x55, 0x2c, 0xbe, 0x00,
+	0x07, 0x0e, 0xdc, 0x39, 0xf0, 0x75, 0x7a, 0xec, 0x11, 0x71, 0xb0, 0x90, 0xf3, 0x0d, 0x38, 0x72,
+	0x42, 0x3b, 0x33, 0xbb, 0x3b, 0x4e, 0xb6, 0x4d, 0xd5, 0x9c, 0xbc, 0xf3, 0xde, 0xef, 0xfd, 0xde,
+	0x9b, 0x37, 0x33, 0xbf, 0x67, 0xa8, 0x7b, 0xf4, 0xbc, 0x77, 0x36, 0xa6, 0xe1, 0xc4, 0x1c, 0x85,
+	0x2c, 0x62, 0xe8, 0x76, 0xe0, 0x87, 0xc4, 0xf4, 0xe8, 0xb9, 0x51, 0x67, 0xa1, 0x47, 0x43, 0x97,
+	0xb1, 0x40, 0xba, 0x8c, 0x95, 0x13, 0x7f, 0xe8, 0x47, 0xc2, 0xaa, 0x2c, 0xe5, 0x28, 0x24, 0x1e,
+	0x55, 0x8b, 0xca, 0x88, 0x84, 0x64, 0xc8, 0xd5, 0x6a, 0x7d, 0xc0, 0x06, 0x4c, 0x7c, 0x5a, 0xf1,
+	0x97, 0xb2, 0x7e, 0xd2, 0x67, 0x7c, 0xc8, 0xb8, 0xe5, 0x12, 0x4e, 0x2d, 0x91, 0xd6, 0x7a, 0xf1,
+	0xc8, 0xa5, 0x11, 0x79, 0x64, 0x8d, 0xc8, 0xc0, 0x3f, 0x25, 0x91, 0xcf, 0x4e, 0x25, 0x16, 0x9b,
+	0xb0, 0xfe, 0x6d, 0x9c, 0xcb, 0x66, 0x2c, 0xb0, 0x27, 0x9d, 0xb6, 0x43, 0xcf, 0xc6, 0x94, 0x47,
+	0x68, 0x03, 0x8a, 0xbe, 0xd7, 0x28, 0xdc, 0x2f, 0x7c, 0x7c, 0xc7, 0x2e, 0xcd, 0xa6, 0xad, 0x62,
+	0xa7, 0xed, 0x14, 0x7d, 0x0f, 0xef, 0xc3, 0x66, 0x8a, 0xe7, 0xf6, 0xa4, 0x4b, 0xfc, 0x30, 0x09,
+	0x41, 0xb0, 0x14, 0x67, 0x94, 0x41, 0x8e, 0xf8, 0x46, 0xeb, 0xb0, 0x7c, 0x36, 0x66, 0x11, 0x6d,
+	0x14, 0x85, 0x51, 0x2e, 0xf0, 0x2f, 0x60, 0xe8, 0x24, 0xfb, 0xe3, 0x90, 0x44, 0x2c, 0xe5, 0xf9,
+	0x09, 0x6e, 0xf5, 0xa5, 0x45, 0x50, 0x55, 0xec, 0xfd, 0x7f, 0xa7, 0xad, 0xda, 0x84, 0x0c, 0x4f,
+	0x76, 0xb1, 0x72, 0xe0, 0xff, 0xa6, 0xad, 0xed, 0x81, 0x1f, 0x1d, 0x8f, 0x5d, 0xb3, 0xcf, 0x86,
+	0x96, 0xda, 0xb0, 0xfc, 0xd9, 0xe6, 0x5e, 0x60, 0x45, 0x93, 0x11, 0xe5, 0xe6, 0x5e, 0xbf, 0xbf,
+	0xe7, 0x79, 0x21, 0xe5, 0xdc, 0x49, 0x38, 0xf1, 0xd7, 0xb0, 0x9a, 0x26, 0x77, 0x28, 0x1f, 0xb1,
+	0x53, 0x4e, 0xd1, 0xa7, 0x00, 0xa2, 0xe5, 0xbd, 0xf8, 0x24, 0x44, 0xda, 0xf2, 0xce, 0x9a, 0x99,
+	0x9c, 0x92, 0x99, 0xb5, 0x68, 0xe9, 0xe5, 0xb4, 0xb5, 0xe0, 0xdc, 0x61, 0x89, 0x01, 0x77, 0x01,
+	0x65, 0x7b, 0x49, 0xf9, 0x76, 0xa1, 0x9c, 0xf1, 0xf1, 0x46, 0xe1, 0xfe, 0xe2, 0x9b, 0x09, 0x21,
+	0x25, 0xe4, 0xd8, 0x82, 0xbb, 0xcf, 0xe2, 0x3b, 0x20, 0x31, 0x6f, 0x71, 0x26, 0x47, 0x70, 0x2f,
+	0x0b, 0xe0, 0xf6, 0x44, 0xdb, 0x9e, 0x0c, 0x7c, 0x0c, 0xd5, 0xac, 0x9a, 0x5e, 0xca, 0x51, 0x9f,
+	0x4d, 0x5b, 0xe5, 0x14, 0xdc, 0x69, 0x3b, 0xe5, 0xb4, 0x8a, 0x8e, 0x87, 0x0f, 0x01, 0x65, 0xac,
+	0xe9, 0xc6, 0x3e, 0x83, 0xb2, 0xb8, 0xa0, 0x3d, 0x01, 0x55, 0x9d, 0x5a, 0xcf, 0x36, 0xa6, 0x55,
+	0xae, 0x76, 0x76, 0x92, 0x5a, 0xf0, 0x11, 0xac, 0x69, 0x85, 0xa6, 0x9c, 0x4f, 0xa0, 0xa2, 0x71,
+	0x26, 0xdd, 0x7a, 0x13, 0x69, 0x39, 0x23, 0xe5, 0xf8, 0x07, 0xa8, 0xb4, 0xe9, 0x28, 0x3a, 0xbe,
+	0xc9, 0x6e, 0xe3, 0x8b, 0x2a, 0x38, 0xc5, 0x45, 0xad, 0x3a, 0x72, 0x81, 0x19, 0x54, 0x15, 0xb5,
+	0x2a, 0xd5, 0x84, 0x25, 0xd7, 0xf7, 0x72, 0x4a, 0xec, 0x86, 0x7e, 0x9f, 0x3e, 0xa3, 0x2f, 0xe8,
+	0x89, 0x2a, 0x51, 0xe0, 0x62, 0x3c, 0xe1, 0x01, 0x6f, 0x14, 0xaf, 0xc7, 0xc7, 0x38, 0xfc, 0x15,
+	0xac, 0xda, 0x94, 0x47, 0xb6, 0xef, 0xed, 0xf1, 0x9b, 0x1d, 0x9f, 0x0b, 0x48, 0x67, 0x52, 0xf5,
+	0xbf, 0x07, 0xb7, 0x5d, 0xca, 0xa3, 0x9e, 0xab, 0x58, 0x16, 0x9d, 0x5b, 0xae, 0x44, 0xa5, 0x2e,
+	0xc2, 0x83, 0x46, 0x31, 0x73, 0xed, 0xf1, 0x00, 0x6d, 0x40, 0x89, 0x8f, 0x42, 0x4a, 0xbc, 0xc6,
+	0xa2, 0x70, 0xa8, 0x15, 0xfe, 0xad, 0x00, 0xd5, 0xa3, 0x90, 0x78, 0x94, 0xdf, 0xa8, 0xf7, 0x07,
+	0x00, 0x99, 0x2e, 0x89, 0xdc, 0xe5, 0x9d, 0x07, 0xa6, 0x7c, 0xcc, 0x66, 0x2c, 0x23, 0xa6, 0xd4,
+	0x4e, 0x25, 0x62, 0x66, 0x97, 0x0c, 0xa8, 0x4a, 0xe8, 0x68, 0x91, 0xf8, 0xd7, 0x02, 0xd4, 0x92,
+	0x72, 0xd4, 0x7e, 0xb7, 0xa1, 0x24, 0xd4, 0x33, 0x39, 0xb1, 0x7a, 0x76, 0x02, 0x02, 0xa9, 0x9a,
+	0xaf, 0x40, 0xe8, 0xcb, 0x9c, 0x4a, 0x1e, 0x5e, 0x5b, 0x89, 0xcc, 0x35, 0x57, 0x4a, 0x1d, 0xaa,
+	0x5d, 0x21, 0xd4, 0xaa, 0x4e, 0xfc, 0x05, 0xd4, 0x12, 0x43, 0x7a, 0x95, 0x4a, 0x52, 0xcb, 0xd5,
+	0x23, 0x5a, 0xd1, 0x2e, 0x87, 0xb0, 0x27, 0xb5, 0x49, 0x14, 0x7e, 0xaa, 0x29, 0xf5, 0x01, 0xbd,
+	0x59, 0xcb, 0xf1, 0x9f, 0x45, 0xb8, 0x7b, 0x89, 0x4d, 0x95, 0x75, 0x04, 0xb5, 0x21, 0x09, 0x68,
+	0xd8, 0xfb, 0x99, 0xd2, 0x5e, 0x48, 0x22, 0xa5, 0xe7, 0xb6, 0x19, 0x17, 0xf3, 0xf7, 0xb4, 0xf5,
+	0xe0, 0x2d, 0x64, 0xb7, 0x4d, 0xfb, 0x4e, 0x45, 0xb0, 0x1c, 0x50, 0xea, 0x90, 0x48, 0xb0, 0x46,
+	0xf3, 0xac, 0xc5, 0x77, 0x63, 0x8d, 0x74, 0xd6, 0xe7, 0xb0, 0xaa, 0x54, 0x5d, 0xf0, 0xf2, 0x63,
+	0x12, 0xd2, 0xc6, 0xe2, 0x3b, 0x11, 0xd7, 0x15, 0xd1, 0x01, 0xa5, 0xdf, 0xc5, 0x34, 0x3b, 0x7f,
+	0x94, 0x60, 0xf9, 0x30, 0x3e, 0x6c, 0xf4, 0x0d, 0x54, 0xe7, 0x46, 0x24, 0x6a, 0xe6, 0xe9, 0x78,
+	0xa6, 0xd3, 0xc6, 0xfb, 0x39, 0xfe, 0xa4, 0xbf, 0x78, 0x01, 0x7d, 0x0f, 0x2b, 0x97, 0x47, 0x28,
+	0xfa, 0x20, 0x27, 0x64, 0x7e, 0xbc, 0x1a, 0x5b, 0x79, 0x10, 0x8d, 0xf6, 0x47, 0x58, 0xcb, 0x19,
+	0xaa, 0xe8, 0xc3, 0x7c, 0xe6, 0xf9, 0x99, 0x7b, 0x2d, 0xf9, 0x21, 0xd4, 0xe6, 0x67, 0x12, 0x6a,
+	0xe5, 0xca, 0xb3, 0xd6, 0x85, 0xad, 0x3c, 0x80, 0x46, 0xe9, 0xc2, 0x46, 0xfe, 0xd4, 0x42, 0x0f,
+	0xf3, 0x22, 0x73, 0xe6, 0x9a, 0x71, 0x2f, 0x17, 0xa8, 0xe5, 0xd8, 0x85, 0x65, 0xa1, 0xdf, 0x68,
+	0x23, 0x43, 0xea, 0xb3, 0xc2, 0xd8, 0xbc, 0x62, 0x4f, 0x63, 0x3b, 0x00, 0x99, 0x80, 0x22, 0xed,
+	0x4c, 0xaf, 0x08, 0xb4, 0xb1, 0x95, 0xef, 0x4c, 0xa9, 0x9e, 0x40, 0x49, 0xea, 0x12, 0xda, 0xbc,
+	0xa4, 0x3f, 0xc9, 0x2b, 0x36, 0x1a, 0x57, 0x1d, 0x7a, 0xb8, 0x54, 0x04, 0x3d, 0x7c, 0x4e, 0x5e,
+	0x8c, 0xc6, 0x55, 0x47, 0x1a, 0xee, 0x40, 0x75, 0xee, 0xa9, 0xe7, 0xde, 0x5f, 0x4d, 0x51, 0x8c,
+	0xd6, 0x6b, 0xfd, 0x09, 0xa7, 0xfd, 0xf9, 0xcb, 0x59, 0xb3, 0xf0, 0x6a, 0xd6, 0x2c, 0xfc, 0x33,
+	0x6b, 0x16, 0x7e, 0xbf, 0x68, 0x2e, 0xbc, 0xba, 0x68, 0x2e, 0xfc, 0x75, 0xd1, 0x5c, 0x78, 0xfe,
+	0x91, 0xf6, 0xe0, 0x9e, 0xfa, 0x21, 0xd9, 0x67, 0x21, 0xb5, 0x38, 0x0d, 0x88, 0x6f, 0x9d, 0x5b,
+	0x1e, 0x3d, 0x97, 0x6f, 0xce, 0x2d, 0x89, 0xbf, 0x9f, 0x8f, 0xff, 0x1f, 0x00, 0xb4, 0xae, 0xa8,
+	0x19, 0x1b, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// OrderBookByID queries an order book by its ID.
+	OrderBookByID(ctx context.Context, in *OrderBookByIDRequest, opts ...grpc.CallOption) (*OrderBookResponse, error)
+	// OrderBooksByPair queries all the order books trading a base/quote pair.
+	OrderBooksByPair(ctx context.Context, in *OrderBooksByPairRequest, opts ...grpc.CallOption) (*OrderBooksResponse, error)
+	// OrderBooksByCurator queries all the order books managed by a curator.
+	OrderBooksByCurator(ctx context.Context, in *OrderBooksByCuratorRequest, opts ...grpc.CallOption) (*OrderBooksResponse, error)
+	// LimitOrderByID queries a limit order by its ID.
+	LimitOrderByID(ctx context.Context, in *LimitOrderByIDRequest, opts ...grpc.CallOption) (*LimitOrderResponse, error)
+	// LimitOrdersByOrderBook queries the limit orders resting on an order book.
+	LimitOrdersByOrderBook(ctx context.Context, in *LimitOrdersByOrderBookRequest, opts ...grpc.CallOption) (*LimitOrdersResponse, error)
+	// Depth queries the resting amount of an order book aggregated by price level.
+	Depth(ctx context.Context, in *DepthRequest, opts ...grpc.CallOption) (*DepthResponse, error)
+	// BestBidAsk queries the best bid, the best ask and the spread of an order book.
+	BestBidAsk(ctx context.Context, in *BestBidAskRequest, opts ...grpc.CallOption) (*BestBidAskResponse, error)
+	// Trades queries the trades of an order book, most recent first.
+	Trades(ctx context.Context, in *TradesRequest, opts ...grpc.CallOption) (*TradesResponse, error)
+	// Params queries the parameters of the dex module.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// OrderBookFees queries the fees charged on the fills of an order book.
+	OrderBookFees(ctx context.Context, in *OrderBookFeesRequest, opts ...grpc.CallOption) (*OrderBookFeesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) OrderBookByID(ctx context.Context, in *OrderBookByIDRequest, opts ...grpc.CallOption) (*OrderBookResponse, error) {
+	out := new(OrderBookResponse)
+	err := c.cc.Invoke(ctx, "/kira.dex.Query/OrderBookByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OrderBooksByPair(ctx context.Context, in *OrderBooksByPairRequest, opts ...grpc.CallOption) (*OrderBooksResponse, error) {
+	out := new(OrderBooksResponse)
+	err := c.cc.Invoke(ctx, "/kira.dex.Query/OrderBooksByPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OrderBooksByCurator(ctx context.Context, in *OrderBooksByCuratorRequest, opts ...grpc.CallOption) (*OrderBooksResponse, error) {
+	out := new(OrderBooksResponse)
+	err := c.cc.Invoke(ctx, "/kira.dex.Query/OrderBooksByCurator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LimitOrderByID(ctx context.Context, in *LimitOrderByIDRequest, opts ...grpc.CallOption) (*LimitOrderResponse, error) {
+	out := new(LimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/kira.dex.Query/LimitOrderByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LimitOrdersByOrderBook(ctx context.Context, in *LimitOrdersByOrderBookRequest, opts ...grpc.CallOption) (*LimitOrdersResponse, error) {
+	out := new(LimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/kira.dex.Query/LimitOrdersByOrderBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Depth(ctx context.Context, in *DepthRequest, opts ...grpc.CallOption) (*DepthResponse, error) {
+	out := new(DepthResponse)
+	err := c.cc.Invoke(ctx, "/kira.dex.Query/Depth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BestBidAsk(ctx context.Context, in *BestBidAskRequest, opts ...grpc.CallOption) (*BestBidAskResponse, error) {
+	out := new(BestBidAskResponse)
+	err := c.cc.Invoke(ctx, "/kira.dex.Query/BestBidAsk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Trades(ctx context.Context, in *TradesRequest, opts ...grpc.CallOption) (*TradesResponse, error) {
+	out := new(TradesResponse)
+	err := c.cc.Invoke(ctx, "/kira.dex.Query/Trades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/kira.dex.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OrderBookFees(ctx context.Context, in *OrderBookFeesRequest, opts ...grpc.CallOption) (*OrderBookFeesResponse, error) {
+	out := new(OrderBookFeesResponse)
+	err := c.cc.Invoke(ctx, "/kira.dex.Query/OrderBookFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// OrderBookByID queries an order book by its ID.
+	OrderBookByID(context.Context, *OrderBookByIDRequest) (*OrderBookResponse, error)
+	// OrderBooksByPair queries all the order books trading a base/quote pair.
+	OrderBooksByPair(context.Context, *OrderBooksByPairRequest) (*OrderBooksResponse, error)
+	// OrderBooksByCurator queries all the order books managed by a curator.
+	OrderBooksByCurator(context.Context, *OrderBooksByCuratorRequest) (*OrderBooksResponse, error)
+	// LimitOrderByID queries a limit order by its ID.
+	LimitOrderByID(context.Context, *LimitOrderByIDRequest) (*LimitOrderResponse, error)
+	// LimitOrdersByOrderBook queries the limit orders resting on an order book.
+	LimitOrdersByOrderBook(context.Context, *LimitOrdersByOrderBookRequest) (*LimitOrdersResponse, error)
+	// Depth queries the resting amount of an order book aggregated by price level.
+	Depth(context.Context, *DepthRequest) (*DepthResponse, error)
+	// BestBidAsk queries the best bid, the best ask and the spread of an order book.
+	BestBidAsk(context.Context, *BestBidAskRequest) (*BestBidAskResponse, error)
+	// Trades queries the trades of an order book, most recent first.
+	Trades(context.Context, *TradesRequest) (*TradesResponse, error)
+	// Params queries the parameters of the dex module.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// OrderBookFees queries the fees charged on the fills of an order book.
+	OrderBookFees(context.Context, *OrderBookFeesRequest) (*OrderBookFeesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) OrderBookByID(ctx context.Context, req *OrderBookByIDRequest) (*OrderBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBookByID not implemented")
+}
+func (*UnimplementedQueryServer) OrderBooksByPair(ctx context.Context, req *OrderBooksByPairRequest) (*OrderBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBooksByPair not implemented")
+}
+func (*UnimplementedQueryServer) OrderBooksByCurator(ctx context.Context, req *OrderBooksByCuratorRequest) (*OrderBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBooksByCurator not implemented")
+}
+func (*UnimplementedQueryServer) LimitOrderByID(ctx context.Context, req *LimitOrderByIDRequest) (*LimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrderByID not implemented")
+}
+func (*UnimplementedQueryServer) LimitOrdersByOrderBook(ctx context.Context, req *LimitOrdersByOrderBookRequest) (*LimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrdersByOrderBook not implemented")
+}
+func (*UnimplementedQueryServer) Depth(ctx context.Context, req *DepthRequest) (*DepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Depth not implemented")
+}
+func (*UnimplementedQueryServer) BestBidAsk(ctx context.Context, req *BestBidAskRequest) (*BestBidAskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BestBidAsk not implemented")
+}
+func (*UnimplementedQueryServer) Trades(ctx context.Context, req *TradesRequest) (*TradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Trades not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) OrderBookFees(ctx context.Context, req *OrderBookFeesRequest) (*OrderBookFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBookFees not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_OrderBookByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderBookByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBookByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.dex.Query/OrderBookByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBookByID(ctx, req.(*OrderBookByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderBooksByPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderBooksByPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBooksByPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.dex.Query/OrderBooksByPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBooksByPair(ctx, req.(*OrderBooksByPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderBooksByCurator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderBooksByCuratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBooksByCurator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.dex.Query/OrderBooksByCurator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBooksByCurator(ctx, req.(*OrderBooksByCuratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LimitOrderByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LimitOrderByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrderByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.dex.Query/LimitOrderByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrderByID(ctx, req.(*LimitOrderByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LimitOrdersByOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LimitOrdersByOrderBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrdersByOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.dex.Query/LimitOrdersByOrderBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrdersByOrderBook(ctx, req.(*LimitOrdersByOrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Depth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Depth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.dex.Query/Depth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Depth(ctx, req.(*DepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BestBidAsk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BestBidAskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BestBidAsk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.dex.Query/BestBidAsk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BestBidAsk(ctx, req.(*BestBidAskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Trades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Trades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.dex.Query/Trades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Trades(ctx, req.(*TradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.dex.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderBookFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderBookFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBookFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kira.dex.Query/OrderBookFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBookFees(ctx, req.(*OrderBookFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kira.dex.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OrderBookByID",
+			Handler:    _Query_OrderBookByID_Handler,
+		},
+		{
+			MethodName: "OrderBooksByPair",
+			Handler:    _Query_OrderBooksByPair_Handler,
+		},
+		{
+			MethodName: "OrderBooksByCurator",
+			Handler:    _Query_OrderBooksByCurator_Handler,
+		},
+		{
+			MethodName: "LimitOrderByID",
+			Handler:    _Query_LimitOrderByID_Handler,
+		},
+		{
+			MethodName: "LimitOrdersByOrderBook",
+			Handler:    _Query_LimitOrdersByOrderBook_Handler,
+		},
+		{
+			MethodName: "Depth",
+			Handler:    _Query_Depth_Handler,
+		},
+		{
+			MethodName: "BestBidAsk",
+			Handler:    _Query_BestBidAsk_Handler,
+		},
+		{
+			MethodName: "Trades",
+			Handler:    _Query_Trades_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "OrderBookFees",
+			Handler:    _Query_OrderBookFees_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dex_query.proto",
+}
+
+func (m *OrderBookByIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintDexQuery(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBooksByPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBooksByPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBooksByPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintDexQuery(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintDexQuery(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBooksByCuratorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBooksByCuratorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBooksByCuratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintDexQuery(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OrderBook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDexQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderBooks) > 0 {
+		for iNdEx := len(m.OrderBooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDexQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrderByIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintDexQuery(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrdersByOrderBookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrdersByOrderBookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrdersByOrderBookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintDexQuery(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDexQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDexQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintDexQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintDexQuery(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDexQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDexQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BestBidAskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BestBidAskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BestBidAskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintDexQuery(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BestBidAskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BestBidAskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BestBidAskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Spread != 0 {
+		i = encodeVarintDexQuery(dAtA, i, uint64(m.Spread))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BestAsk != 0 {
+		i = encodeVarintDexQuery(dAtA, i, uint64(m.BestAsk))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BestBid != 0 {
+		i = encodeVarintDexQuery(dAtA, i, uint64(m.BestBid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDexQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintDexQuery(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDexQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDexQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDexQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintDexQuery(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CuratorFeeShare.Size()
+		i -= size
+		if _, err := m.CuratorFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDexQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDexQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDexQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDexQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDexQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OrderBookByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovDexQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OrderBooksByPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovDexQuery(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovDexQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OrderBooksByCuratorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovDexQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OrderBookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderBook.Size()
+	n += 1 + l + sovDexQuery(uint64(l))
+	return n
+}
+
+func (m *OrderBooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderBooks) > 0 {
+		for _, e := range m.OrderBooks {
+			l = e.Size()
+			n += 1 + l + sovDexQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LimitOrderByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovDexQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LimitOrdersByOrderBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovDexQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LimitOrder.Size()
+	n += 1 + l + sovDexQuery(uint64(l))
+	return n
+}
+
+func (m *LimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovDexQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DepthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovDexQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDexQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *DepthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovDexQuery(uint64(l))
+		}
+	}
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovDexQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BestBidAskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovDexQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BestBidAskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BestBid != 0 {
+		n += 1 + sovDexQuery(uint64(m.BestBid))
+	}
+	if m.BestAsk != 0 {
+		n += 1 + sovDexQuery(uint64(m.BestAsk))
+	}
+	if m.Spread != 0 {
+		n += 1 + sovDexQuery(uint64(m.Spread))
+	}
+	return n
+}
+
+func (m *TradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovDexQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovDexQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovDexQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovDexQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovDexQuery(uint64(l))
+	return n
+}
+
+func (m *OrderBookFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovDexQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OrderBookFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovDexQuery(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovDexQuery(uint64(l))
+	l = m.CuratorFeeShare.Size()
+	n += 1 + l + sovDexQuery(uint64(l))
+	return n
+}
+
+func sovDexQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDexQuery(x uint64) (n int) {
+	return sovDexQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OrderBookByIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookByIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBooksByPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBooksByPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBooksByPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBooksByCuratorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBooksByCuratorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBooksByCuratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = append(m.Curator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Curator == nil {
+				m.Curator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderBook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBooksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBooksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBooks = append(m.OrderBooks, types.OrderBook{})
+			if err := m.OrderBooks[len(m.OrderBooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrderByIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrderByIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrderByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrdersByOrderBookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrdersByOrderBookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrdersByOrderBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, types.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, PriceLevel{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asks = append(m.Asks, PriceLevel{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BestBidAskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BestBidAskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BestBidAskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BestBidAskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BestBidAskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BestBidAskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestBid", wireType)
+			}
+			m.BestBid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestBid |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestAsk", wireType)
+			}
+			m.BestAsk = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestAsk |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spread", wireType)
+			}
+			m.Spread = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Spread |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CuratorFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CuratorFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDexQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDexQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDexQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDexQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDexQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDexQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDexQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDexQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDexQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDexQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDexQuery = fmt.Errorf("proto: unexpected end of group")
+)
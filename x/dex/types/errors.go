@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	ErrInvalidPair           = sdkerrors.Register(ModuleName, 2, "invalid base/quote pair")
+	ErrInvalidMnemonicLength = sdkerrors.Register(ModuleName, 3, "invalid mnemonic length (max 64 bytes)")
+	ErrOrderBookNotFound     = sdkerrors.Register(ModuleName, 4, "order book not found")
+	ErrInvalidOrderType      = sdkerrors.Register(ModuleName, 5, "invalid order type")
+	ErrInvalidAmount         = sdkerrors.Register(ModuleName, 6, "invalid order amount")
+	ErrInvalidLimitPrice     = sdkerrors.Register(ModuleName, 7, "invalid limit price")
+	ErrLimitOrderNotFound    = sdkerrors.Register(ModuleName, 8, "limit order not found")
+	ErrInvalidExpiryTime     = sdkerrors.Register(ModuleName, 10, "invalid expiry time")
+	ErrOrderBookPaused       = sdkerrors.Register(ModuleName, 11, "order book is paused")
+	ErrInvalidTickSize       = sdkerrors.Register(ModuleName, 12, "invalid tick size")
+	ErrInvalidMinOrderAmount = sdkerrors.Register(ModuleName, 13, "invalid minimum order amount")
+	ErrOrderNotFilled        = sdkerrors.Register(ModuleName, 14, "order can not be filled")
+)
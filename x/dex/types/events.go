@@ -0,0 +1,33 @@
+package types
+
+// dex module event types
+const (
+	EventTypeCreateOrderBook       = "create_order_book"
+	EventTypeCreateLimitOrder      = "create_limit_order"
+	EventTypeExecuteImmediateOrder = "execute_immediate_order"
+	EventTypeCancelLimitOrder      = "cancel_limit_order"
+	EventTypeExpireLimitOrder      = "expire_limit_order"
+	EventTypeFill                  = "fill"
+
+	EventTypeSetOrderBookPaused           = "set_order_book_paused"
+	EventTypeSetOrderBookLimits           = "set_order_book_limits"
+	EventTypeSetOrderBookMnemonic         = "set_order_book_mnemonic"
+	EventTypeTransferOrderBookCuratorship = "transfer_order_book_curatorship"
+	EventTypeDelistOrderBook              = "delist_order_book"
+	EventTypeReassignOrderBook            = "reassign_order_book"
+
+	AttributeKeyOrderBookID    = "order_book_id"
+	AttributeKeyLimitOrderID   = "limit_order_id"
+	AttributeKeyIndex          = "index"
+	AttributeKeyCurator        = "curator"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyFilledAmount   = "filled_amount"
+	AttributeKeyUnfilledAmount = "unfilled_amount"
+	AttributeKeyPrice          = "price"
+	AttributeKeyBuyOrderID     = "buy_order_id"
+	AttributeKeySellOrderID    = "sell_order_id"
+	AttributeKeyBuyFee         = "buy_fee"
+	AttributeKeySellFee        = "sell_fee"
+	AttributeKeyNewCurator     = "new_curator"
+	AttributeKeyIsPaused       = "is_paused"
+)
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+
+	kiratypes "github.com/KiraCore/sekai/types"
+)
+
+// NewGenesisState creates a new dex genesis state.
+func NewGenesisState(
+	params Params,
+	orderBooks []kiratypes.OrderBook,
+	limitOrders []kiratypes.LimitOrder,
+	lastOrderBookIndex uint32,
+	lastLimitOrderIndex uint32,
+	lastTradeIndex uint64,
+	trades []Trade,
+) *GenesisState {
+	return &GenesisState{
+		Params:              params,
+		OrderBooks:          orderBooks,
+		LimitOrders:         limitOrders,
+		LastOrderBookIndex:  lastOrderBookIndex,
+		LastLimitOrderIndex: lastLimitOrderIndex,
+		LastTradeIndex:      lastTradeIndex,
+		Trades:              trades,
+	}
+}
+
+// DefaultGenesis returns the default dex genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), []kiratypes.OrderBook{}, []kiratypes.LimitOrder{}, 0, 0, 0, []Trade{})
+}
+
+// ValidateGenesis validates the dex genesis state. Every limit order and trade
+// must belong to one of the order books, and no index may be used twice or be
+// ahead of its sequence counter.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	orderBooks := make(map[string]bool, len(data.OrderBooks))
+	orderBookIndexes := make(map[uint32]bool, len(data.OrderBooks))
+	for _, orderBook := range data.OrderBooks {
+		if orderBook.ID == "" {
+			return fmt.Errorf("order book %d has no id", orderBook.Index)
+		}
+		if orderBooks[orderBook.ID] {
+			return fmt.Errorf("duplicate order book %s", orderBook.ID)
+		}
+		orderBooks[orderBook.ID] = true
+
+		if orderBookIndexes[orderBook.Index] {
+			return fmt.Errorf("duplicate order book index %d", orderBook.Index)
+		}
+		orderBookIndexes[orderBook.Index] = true
+
+		if orderBook.Index > data.LastOrderBookIndex {
+			return fmt.Errorf("order book %s index %d is above the last order book index %d", orderBook.ID, orderBook.Index, data.LastOrderBookIndex)
+		}
+		if orderBook.Curator.Empty() {
+			return fmt.Errorf("order book %s has no curator", orderBook.ID)
+		}
+	}
+
+	limitOrders := make(map[string]bool, len(data.LimitOrders))
+	for _, order := range data.LimitOrders {
+		if order.ID == "" {
+			return fmt.Errorf("limit order %d has no id", order.Index)
+		}
+		if limitOrders[order.ID] {
+			return fmt.Errorf("duplicate limit order %s", order.ID)
+		}
+		limitOrders[order.ID] = true
+
+		if !orderBooks[order.OrderBookID] {
+			return fmt.Errorf("limit order %s rests on unknown order book %s", order.ID, order.OrderBookID)
+		}
+		if order.Index > data.LastLimitOrderIndex {
+			return fmt.Errorf("limit order %s index %d is above the last limit order index %d", order.ID, order.Index, data.LastLimitOrderIndex)
+		}
+		if !IsLimitOrderType(order.OrderType) || order.IsCancelled {
+			return fmt.Errorf("limit order %s is not resting", order.ID)
+		}
+		if order.Amount <= 0 || order.LimitPrice <= 0 || order.Curator.Empty() {
+			return fmt.Errorf("invalid limit order %s", order.ID)
+		}
+	}
+
+	trades := make(map[uint64]bool, len(data.Trades))
+	for _, trade := range data.Trades {
+		if trades[trade.Index] {
+			return fmt.Errorf("duplicate trade %d", trade.Index)
+		}
+		trades[trade.Index] = true
+
+		if !orderBooks[trade.OrderBookID] {
+			return fmt.Errorf("trade %d belongs to unknown order book %s", trade.Index, trade.OrderBookID)
+		}
+		if trade.Index == 0 || trade.Index > data.LastTradeIndex {
+			return fmt.Errorf("trade %d index is not between 1 and the last trade index %d", trade.Index, data.LastTradeIndex)
+		}
+		if trade.Amount <= 0 || trade.Price <= 0 {
+			return fmt.Errorf("invalid trade %d", trade.Index)
+		}
+	}
+
+	return nil
+}
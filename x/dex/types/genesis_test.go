@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kiratypes "github.com/KiraCore/sekai/types"
+	"github.com/KiraCore/sekai/x/dex/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	curator := sdk.AccAddress("curator_____________")
+
+	orderBook := kiratypes.OrderBook{ID: "book", Index: 1, Base: "ukex", Quote: "ubtc", Curator: curator}
+	order := kiratypes.LimitOrder{
+		ID: "order", Index: 1, OrderBookID: "book", OrderType: types.OrderTypeLimitBuy, Amount: 10, LimitPrice: 2, Curator: curator,
+	}
+
+	trade := types.Trade{Index: 1, OrderBookID: "book", BuyOrderID: "order", SellOrderID: "filled", Amount: 5, Price: 2}
+
+	valid := func() *types.GenesisState {
+		return types.NewGenesisState(types.DefaultParams(), []kiratypes.OrderBook{orderBook}, []kiratypes.LimitOrder{order}, 1, 1, 1, []types.Trade{trade})
+	}
+
+	require.NoError(t, types.ValidateGenesis(*types.DefaultGenesis()))
+	require.NoError(t, types.ValidateGenesis(*valid()))
+
+	tests := []struct {
+		name     string
+		malleate func(*types.GenesisState)
+	}{
+		{"duplicate order book", func(g *types.GenesisState) { g.OrderBooks = append(g.OrderBooks, orderBook) }},
+		{"duplicate order book index", func(g *types.GenesisState) {
+			g.OrderBooks = append(g.OrderBooks, kiratypes.OrderBook{ID: "other", Index: 1, Base: "ukex", Quote: "ueth", Curator: curator})
+		}},
+		{"order book index ahead of sequence", func(g *types.GenesisState) { g.LastOrderBookIndex = 0 }},
+		{"duplicate limit order", func(g *types.GenesisState) { g.LimitOrders = append(g.LimitOrders, order) }},
+		{"orphaned limit order", func(g *types.GenesisState) { g.LimitOrders[0].OrderBookID = "unknown" }},
+		{"limit order index ahead of sequence", func(g *types.GenesisState) { g.LastLimitOrderIndex = 0 }},
+		{"cancelled limit order", func(g *types.GenesisState) { g.LimitOrders[0].IsCancelled = true }},
+		{"market order", func(g *types.GenesisState) { g.LimitOrders[0].OrderType = types.OrderTypeMarketBuy }},
+		{"duplicate trade", func(g *types.GenesisState) { g.Trades = append(g.Trades, trade) }},
+		{"orphaned trade", func(g *types.GenesisState) { g.Trades[0].OrderBookID = "unknown" }},
+		{"trade index ahead of sequence", func(g *types.GenesisState) { g.LastTradeIndex = 0 }},
+		{"empty trade", func(g *types.GenesisState) { g.Trades[0].Amount = 0 }},
+		{"invalid params", func(g *types.GenesisState) { g.Params.MakerFeeRate = sdk.NewDec(-1) }},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			genesis := valid()
+			tt.malleate(genesis)
+			require.Error(t, types.ValidateGenesis(*genesis))
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the dex module
+	ModuleName = "dex"
+
+	// StoreKey is the store key string for the dex module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the dex module
+	RouterKey = ModuleName
+
+	CreateOrderBook  = "create-order-book"
+	CreateLimitOrder = "create-limit-order"
+	CancelLimitOrder = "cancel-limit-order"
+
+	SetOrderBookPaused           = "set-order-book-paused"
+	SetOrderBookLimits           = "set-order-book-limits"
+	SetOrderBookMnemonic         = "set-order-book-mnemonic"
+	TransferOrderBookCuratorship = "transfer-order-book-curatorship"
+)
+
+var (
+	OrderBooksKey          = []byte{0x31} // Order books key prefix.
+	OrderBooksByPairKey    = []byte{0x32} // Order books by base/quote pair prefix.
+	OrderBooksByCuratorKey = []byte{0x33} // Order books by curator prefix.
+	LastOrderBookIndexKey  = []byte{0x34} // Key of the last assigned order book index.
+
+	LimitOrdersKey            = []byte{0x35} // Limit orders key prefix.
+	LimitOrdersByOrderBookKey = []byte{0x36} // Resting limit orders by order book prefix.
+	LastLimitOrderIndexKey    = []byte{0x37} // Key of the last assigned limit order index.
+	LimitOrdersByExpiryKey    = []byte{0x38} // Resting limit orders by expiry time prefix.
+	TradesKey                 = []byte{0x39} // Trades by order book prefix.
+	LastTradeIndexKey         = []byte{0x3A} // Key of the last assigned trade index.
+)
+
+// GetOrderBookKey gets the key for the order book with id
+func GetOrderBookKey(id string) []byte {
+	return append(OrderBooksKey, []byte(id)...)
+}
+
+// GetOrderBooksByPairPrefix gets the prefix of every order book trading base against quote.
+// Both denoms are length prefixed so that no pair is a prefix of another one.
+func GetOrderBooksByPairPrefix(base string, quote string) []byte {
+	key := append(OrderBooksByPairKey, lengthPrefix([]byte(base))...)
+	return append(key, lengthPrefix([]byte(quote))...)
+}
+
+func GetOrderBookByPairKey(base string, quote string, id string) []byte {
+	return append(GetOrderBooksByPairPrefix(base, quote), []byte(id)...)
+}
+
+// GetOrderBooksByCuratorPrefix gets the prefix of every order book managed by curator.
+func GetOrderBooksByCuratorPrefix(curator []byte) []byte {
+	return append(OrderBooksByCuratorKey, lengthPrefix(curator)...)
+}
+
+func GetOrderBookByCuratorKey(curator []byte, id string) []byte {
+	return append(GetOrderBooksByCuratorPrefix(curator), []byte(id)...)
+}
+
+// GetLimitOrderKey gets the key for the limit order with id
+func GetLimitOrderKey(id string) []byte {
+	return append(LimitOrdersKey, []byte(id)...)
+}
+
+// GetLimitOrdersByOrderBookPrefix gets the prefix of every limit order resting on an order book.
+func GetLimitOrdersByOrderBookPrefix(orderBookID string) []byte {
+	return append(LimitOrdersByOrderBookKey, lengthPrefix([]byte(orderBookID))...)
+}
+
+// GetLimitOrdersBySidePrefix gets the prefix of the limit orders resting on one side of an order book.
+func GetLimitOrdersBySidePrefix(orderBookID string, orderType uint8) []byte {
+	return append(GetLimitOrdersByOrderBookPrefix(orderBookID), orderType)
+}
+
+// GetLimitOrderByOrderBookKey gets the key of a resting limit order. Keys of a
+// side sort by price priority (highest bid, lowest ask) and then by order
+// index, so iterating a side prefix walks the orders in matching order.
+func GetLimitOrderByOrderBookKey(orderBookID string, orderType uint8, limitPrice int64, index uint32) []byte {
+	price := uint64(limitPrice)
+	if orderType == OrderTypeLimitBuy {
+		price = ^price
+	}
+
+	indexBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(indexBz, index)
+
+	key := append(GetLimitOrdersBySidePrefix(orderBookID, orderType), sdk.Uint64ToBigEndian(price)...)
+	return append(key, indexBz...)
+}
+
+// GetLimitOrdersByExpiryPrefix gets the prefix of every limit order expiring at expiryTime.
+// Expiry times are big endian encoded so the index iterates from the earliest expiry.
+func GetLimitOrdersByExpiryPrefix(expiryTime int64) []byte {
+	return append(LimitOrdersByExpiryKey, sdk.Uint64ToBigEndian(uint64(expiryTime))...)
+}
+
+func GetLimitOrderByExpiryKey(expiryTime int64, id string) []byte {
+	return append(GetLimitOrdersByExpiryPrefix(expiryTime), []byte(id)...)
+}
+
+// GetTradesByOrderBookPrefix gets the prefix of the trades of an order book.
+func GetTradesByOrderBookPrefix(orderBookID string) []byte {
+	return append(TradesKey, lengthPrefix([]byte(orderBookID))...)
+}
+
+// GetTradeKey gets the key of a trade. The index is inverted so iterating the
+// trades of an order book walks them from the most recent one.
+func GetTradeKey(orderBookID string, index uint64) []byte {
+	return append(GetTradesByOrderBookPrefix(orderBookID), sdk.Uint64ToBigEndian(^index)...)
+}
+
+func lengthPrefix(bz []byte) []byte {
+	return append([]byte{byte(len(bz))}, bz...)
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLimitOrderByOrderBookKey_Priority(t *testing.T) {
+	// Bids sort highest price first, asks lowest price first, and equal prices by index.
+	require.Equal(t, -1, bytes.Compare(
+		GetLimitOrderByOrderBookKey("book", OrderTypeLimitBuy, 10, 2),
+		GetLimitOrderByOrderBookKey("book", OrderTypeLimitBuy, 9, 1),
+	))
+	require.Equal(t, -1, bytes.Compare(
+		GetLimitOrderByOrderBookKey("book", OrderTypeLimitBuy, 10, 1),
+		GetLimitOrderByOrderBookKey("book", OrderTypeLimitBuy, 10, 2),
+	))
+	require.Equal(t, -1, bytes.Compare(
+		GetLimitOrderByOrderBookKey("book", OrderTypeLimitSell, 9, 2),
+		GetLimitOrderByOrderBookKey("book", OrderTypeLimitSell, 10, 1),
+	))
+	require.Equal(t, -1, bytes.Compare(
+		GetLimitOrderByOrderBookKey("book", OrderTypeLimitSell, 10, 1),
+		GetLimitOrderByOrderBookKey("book", OrderTypeLimitSell, 10, 2),
+	))
+}
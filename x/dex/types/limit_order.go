@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	kiratypes "github.com/KiraCore/sekai/types"
+)
+
+// Order types. A buy order offers quote to receive Amount of base, a sell
+// order offers Amount of base to receive quote. LimitPrice is always expressed
+// in quote units per base unit.
+//
+// Only limit orders rest on their order book. Market, immediate-or-cancel and
+// fill-or-kill orders are matched against the resting orders as soon as they
+// are placed: a market order fills at any price, an IOC order fills up to its
+// limit price, and a FOK order either fills completely up to its limit price
+// or fails. Whatever they can not fill is dropped.
+const (
+	OrderTypeLimitBuy uint8 = iota + 1
+	OrderTypeLimitSell
+	OrderTypeMarketBuy
+	OrderTypeMarketSell
+	OrderTypeIOCBuy
+	OrderTypeIOCSell
+	OrderTypeFOKBuy
+	OrderTypeFOKSell
+)
+
+var orderTypeNames = map[uint8]string{
+	OrderTypeLimitBuy:   "limit-buy",
+	OrderTypeLimitSell:  "limit-sell",
+	OrderTypeMarketBuy:  "market-buy",
+	OrderTypeMarketSell: "market-sell",
+	OrderTypeIOCBuy:     "ioc-buy",
+	OrderTypeIOCSell:    "ioc-sell",
+	OrderTypeFOKBuy:     "fok-buy",
+	OrderTypeFOKSell:    "fok-sell",
+}
+
+// ValidateOrderType returns an error if orderType is not a known order type.
+func ValidateOrderType(orderType uint8) error {
+	if _, ok := orderTypeNames[orderType]; !ok {
+		return ErrInvalidOrderType
+	}
+
+	return nil
+}
+
+// OrderTypeFromString returns the order type named s, e.g. "limit-buy" or "fok-sell".
+func OrderTypeFromString(s string) (uint8, error) {
+	for orderType, name := range orderTypeNames {
+		if name == s {
+			return orderType, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown order type %q", s)
+}
+
+// OrderTypeString returns the name of an order type.
+func OrderTypeString(orderType uint8) string {
+	return orderTypeNames[orderType]
+}
+
+// IsBuyOrderType reports whether orders of orderType buy base.
+func IsBuyOrderType(orderType uint8) bool {
+	switch orderType {
+	case OrderTypeLimitBuy, OrderTypeMarketBuy, OrderTypeIOCBuy, OrderTypeFOKBuy:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsLimitOrderType reports whether orders of orderType rest on their order book.
+func IsLimitOrderType(orderType uint8) bool {
+	return orderType == OrderTypeLimitBuy || orderType == OrderTypeLimitSell
+}
+
+// IsMarketOrderType reports whether orders of orderType fill at any price.
+func IsMarketOrderType(orderType uint8) bool {
+	return orderType == OrderTypeMarketBuy || orderType == OrderTypeMarketSell
+}
+
+// IsFOKOrderType reports whether orders of orderType must fill completely.
+func IsFOKOrderType(orderType uint8) bool {
+	return orderType == OrderTypeFOKBuy || orderType == OrderTypeFOKSell
+}
+
+// OfferedCoins returns the funds that back amount of base units of an order
+// on orderBook: amount*LimitPrice quote for a buy, amount base for a sell.
+func OfferedCoins(orderBook kiratypes.OrderBook, order kiratypes.LimitOrder, amount int64) sdk.Coins {
+	if IsBuyOrderType(order.OrderType) {
+		return sdk.NewCoins(sdk.NewCoin(orderBook.Quote, sdk.NewInt(amount).MulRaw(order.LimitPrice)))
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(orderBook.Base, sdk.NewInt(amount)))
+}
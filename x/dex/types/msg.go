@@ -0,0 +1,342 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateOrderBook{}
+
+func NewMsgCreateOrderBook(base string, quote string, mnemonic string, curator sdk.AccAddress) *MsgCreateOrderBook {
+	return &MsgCreateOrderBook{
+		Base:     base,
+		Quote:    quote,
+		Mnemonic: mnemonic,
+		Curator:  curator,
+	}
+}
+
+func (m MsgCreateOrderBook) Route() string {
+	return RouterKey
+}
+
+func (m MsgCreateOrderBook) Type() string {
+	return CreateOrderBook
+}
+
+func (m MsgCreateOrderBook) ValidateBasic() error {
+	if m.Curator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "curator not set")
+	}
+
+	if err := sdk.ValidateDenom(m.Base); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPair, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(m.Quote); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPair, err.Error())
+	}
+
+	if m.Base == m.Quote {
+		return sdkerrors.Wrap(ErrInvalidPair, "base and quote must be different")
+	}
+
+	if len(m.Mnemonic) > 64 {
+		return ErrInvalidMnemonicLength
+	}
+
+	return nil
+}
+
+func (m MsgCreateOrderBook) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgCreateOrderBook) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Curator}
+}
+
+var _ sdk.Msg = &MsgCreateLimitOrder{}
+
+func NewMsgCreateLimitOrder(
+	orderBookID string,
+	orderType uint8,
+	amount int64,
+	limitPrice int64,
+	expiryTime int64,
+	curator sdk.AccAddress,
+) *MsgCreateLimitOrder {
+	return &MsgCreateLimitOrder{
+		OrderBookID: orderBookID,
+		OrderType:   orderType,
+		Amount:      amount,
+		LimitPrice:  limitPrice,
+		ExpiryTime:  expiryTime,
+		Curator:     curator,
+	}
+}
+
+func (m MsgCreateLimitOrder) Route() string {
+	return RouterKey
+}
+
+func (m MsgCreateLimitOrder) Type() string {
+	return CreateLimitOrder
+}
+
+func (m MsgCreateLimitOrder) ValidateBasic() error {
+	if m.Curator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "curator not set")
+	}
+
+	if m.OrderBookID == "" {
+		return sdkerrors.Wrap(ErrOrderBookNotFound, "order book id not set")
+	}
+
+	if err := ValidateOrderType(m.OrderType); err != nil {
+		return err
+	}
+
+	if m.Amount <= 0 {
+		return ErrInvalidAmount
+	}
+
+	if IsMarketOrderType(m.OrderType) {
+		if m.LimitPrice != 0 {
+			return sdkerrors.Wrap(ErrInvalidLimitPrice, "market orders have no limit price")
+		}
+	} else if m.LimitPrice <= 0 {
+		return ErrInvalidLimitPrice
+	}
+
+	if m.ExpiryTime < 0 {
+		return ErrInvalidExpiryTime
+	}
+
+	if m.ExpiryTime != 0 && !IsLimitOrderType(m.OrderType) {
+		return sdkerrors.Wrap(ErrInvalidExpiryTime, "only limit orders rest on the order book until they expire")
+	}
+
+	return nil
+}
+
+func (m MsgCreateLimitOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgCreateLimitOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Curator}
+}
+
+var _ sdk.Msg = &MsgCancelLimitOrder{}
+
+func NewMsgCancelLimitOrder(limitOrderID string, curator sdk.AccAddress) *MsgCancelLimitOrder {
+	return &MsgCancelLimitOrder{
+		LimitOrderID: limitOrderID,
+		Curator:      curator,
+	}
+}
+
+func (m MsgCancelLimitOrder) Route() string {
+	return RouterKey
+}
+
+func (m MsgCancelLimitOrder) Type() string {
+	return CancelLimitOrder
+}
+
+func (m MsgCancelLimitOrder) ValidateBasic() error {
+	if m.Curator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "curator not set")
+	}
+
+	if m.LimitOrderID == "" {
+		return sdkerrors.Wrap(ErrLimitOrderNotFound, "limit order id not set")
+	}
+
+	return nil
+}
+
+func (m MsgCancelLimitOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Curator}
+}
+
+var _ sdk.Msg = &MsgSetOrderBookPaused{}
+
+func NewMsgSetOrderBookPaused(orderBookID string, isPaused bool, curator sdk.AccAddress) *MsgSetOrderBookPaused {
+	return &MsgSetOrderBookPaused{
+		OrderBookID: orderBookID,
+		IsPaused:    isPaused,
+		Curator:     curator,
+	}
+}
+
+func (m MsgSetOrderBookPaused) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetOrderBookPaused) Type() string {
+	return SetOrderBookPaused
+}
+
+func (m MsgSetOrderBookPaused) ValidateBasic() error {
+	if m.Curator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "curator not set")
+	}
+
+	if m.OrderBookID == "" {
+		return sdkerrors.Wrap(ErrOrderBookNotFound, "order book id not set")
+	}
+
+	return nil
+}
+
+func (m MsgSetOrderBookPaused) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgSetOrderBookPaused) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Curator}
+}
+
+var _ sdk.Msg = &MsgSetOrderBookLimits{}
+
+func NewMsgSetOrderBookLimits(orderBookID string, tickSize int64, minOrderAmount int64, curator sdk.AccAddress) *MsgSetOrderBookLimits {
+	return &MsgSetOrderBookLimits{
+		OrderBookID:    orderBookID,
+		TickSize:       tickSize,
+		MinOrderAmount: minOrderAmount,
+		Curator:        curator,
+	}
+}
+
+func (m MsgSetOrderBookLimits) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetOrderBookLimits) Type() string {
+	return SetOrderBookLimits
+}
+
+func (m MsgSetOrderBookLimits) ValidateBasic() error {
+	if m.Curator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "curator not set")
+	}
+
+	if m.OrderBookID == "" {
+		return sdkerrors.Wrap(ErrOrderBookNotFound, "order book id not set")
+	}
+
+	if m.TickSize < 0 {
+		return ErrInvalidTickSize
+	}
+
+	if m.MinOrderAmount < 0 {
+		return ErrInvalidMinOrderAmount
+	}
+
+	return nil
+}
+
+func (m MsgSetOrderBookLimits) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgSetOrderBookLimits) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Curator}
+}
+
+var _ sdk.Msg = &MsgSetOrderBookMnemonic{}
+
+func NewMsgSetOrderBookMnemonic(orderBookID string, mnemonic string, curator sdk.AccAddress) *MsgSetOrderBookMnemonic {
+	return &MsgSetOrderBookMnemonic{
+		OrderBookID: orderBookID,
+		Mnemonic:    mnemonic,
+		Curator:     curator,
+	}
+}
+
+func (m MsgSetOrderBookMnemonic) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetOrderBookMnemonic) Type() string {
+	return SetOrderBookMnemonic
+}
+
+func (m MsgSetOrderBookMnemonic) ValidateBasic() error {
+	if m.Curator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "curator not set")
+	}
+
+	if m.OrderBookID == "" {
+		return sdkerrors.Wrap(ErrOrderBookNotFound, "order book id not set")
+	}
+
+	if len(m.Mnemonic) > 64 {
+		return ErrInvalidMnemonicLength
+	}
+
+	return nil
+}
+
+func (m MsgSetOrderBookMnemonic) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgSetOrderBookMnemonic) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Curator}
+}
+
+var _ sdk.Msg = &MsgTransferOrderBookCuratorship{}
+
+func NewMsgTransferOrderBookCuratorship(orderBookID string, newCurator sdk.AccAddress, curator sdk.AccAddress) *MsgTransferOrderBookCuratorship {
+	return &MsgTransferOrderBookCuratorship{
+		OrderBookID: orderBookID,
+		NewCurator:  newCurator,
+		Curator:     curator,
+	}
+}
+
+func (m MsgTransferOrderBookCuratorship) Route() string {
+	return RouterKey
+}
+
+func (m MsgTransferOrderBookCuratorship) Type() string {
+	return TransferOrderBookCuratorship
+}
+
+func (m MsgTransferOrderBookCuratorship) ValidateBasic() error {
+	if m.Curator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "curator not set")
+	}
+
+	if m.NewCurator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "new curator not set")
+	}
+
+	if m.OrderBookID == "" {
+		return sdkerrors.Wrap(ErrOrderBookNotFound, "order book id not set")
+	}
+
+	return nil
+}
+
+func (m MsgTransferOrderBookCuratorship) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgTransferOrderBookCuratorship) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Curator}
+}
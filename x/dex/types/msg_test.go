@@ -0,0 +1,176 @@
+package types_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/KiraCore/sekai/x/dex/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgCreateOrderBook_ValidateBasic(t *testing.T) {
+	curator := sdk.AccAddress("curator_____________")
+
+	tests := []struct {
+		name string
+		msg  *types.MsgCreateOrderBook
+		err  error
+	}{
+		{
+			name: "nil curator",
+			msg:  types.NewMsgCreateOrderBook("ukex", "ubtc", "", nil),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid base",
+			msg:  types.NewMsgCreateOrderBook("1", "ubtc", "", curator),
+			err:  types.ErrInvalidPair,
+		},
+		{
+			name: "invalid quote",
+			msg:  types.NewMsgCreateOrderBook("ukex", "", "", curator),
+			err:  types.ErrInvalidPair,
+		},
+		{
+			name: "same base and quote",
+			msg:  types.NewMsgCreateOrderBook("ukex", "ukex", "", curator),
+			err:  types.ErrInvalidPair,
+		},
+		{
+			name: "mnemonic longer than 64",
+			msg:  types.NewMsgCreateOrderBook("ukex", "ubtc", strings.Repeat("A", 65), curator),
+			err:  types.ErrInvalidMnemonicLength,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			require.Error(t, err)
+			require.True(t, errors.Is(err, tt.err), err.Error())
+		})
+	}
+
+	require.NoError(t, types.NewMsgCreateOrderBook("ukex", "ubtc", "KEX/BTC", curator).ValidateBasic())
+}
+
+func TestMsgCreateLimitOrder_ValidateBasic(t *testing.T) {
+	curator := sdk.AccAddress("curator_____________")
+
+	tests := []struct {
+		name string
+		msg  *types.MsgCreateLimitOrder
+		err  error
+	}{
+		{
+			name: "nil curator",
+			msg:  types.NewMsgCreateLimitOrder("book", types.OrderTypeLimitBuy, 10, 2, 0, nil),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty order book",
+			msg:  types.NewMsgCreateLimitOrder("", types.OrderTypeLimitBuy, 10, 2, 0, curator),
+			err:  types.ErrOrderBookNotFound,
+		},
+		{
+			name: "unknown order type",
+			msg:  types.NewMsgCreateLimitOrder("book", 0, 10, 2, 0, curator),
+			err:  types.ErrInvalidOrderType,
+		},
+		{
+			name: "zero amount",
+			msg:  types.NewMsgCreateLimitOrder("book", types.OrderTypeLimitSell, 0, 2, 0, curator),
+			err:  types.ErrInvalidAmount,
+		},
+		{
+			name: "negative limit price",
+			msg:  types.NewMsgCreateLimitOrder("book", types.OrderTypeLimitSell, 10, -2, 0, curator),
+			err:  types.ErrInvalidLimitPrice,
+		},
+		{
+			name: "market order with a limit price",
+			msg:  types.NewMsgCreateLimitOrder("book", types.OrderTypeMarketBuy, 10, 2, 0, curator),
+			err:  types.ErrInvalidLimitPrice,
+		},
+		{
+			name: "IOC order without a limit price",
+			msg:  types.NewMsgCreateLimitOrder("book", types.OrderTypeIOCSell, 10, 0, 0, curator),
+			err:  types.ErrInvalidLimitPrice,
+		},
+		{
+			name: "FOK order with an expiry time",
+			msg:  types.NewMsgCreateLimitOrder("book", types.OrderTypeFOKBuy, 10, 2, 100, curator),
+			err:  types.ErrInvalidExpiryTime,
+		},
+		{
+			name: "order type out of range",
+			msg:  types.NewMsgCreateLimitOrder("book", types.OrderTypeFOKSell+1, 10, 2, 0, curator),
+			err:  types.ErrInvalidOrderType,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			require.Error(t, err)
+			require.True(t, errors.Is(err, tt.err), err.Error())
+		})
+	}
+
+	require.NoError(t, types.NewMsgCreateLimitOrder("book", types.OrderTypeLimitSell, 10, 2, 0, curator).ValidateBasic())
+	require.NoError(t, types.NewMsgCreateLimitOrder("book", types.OrderTypeMarketSell, 10, 0, 0, curator).ValidateBasic())
+}
+
+func TestMsgSetOrderBookLimits_ValidateBasic(t *testing.T) {
+	curator := sdk.AccAddress("curator_____________")
+
+	tests := []struct {
+		name string
+		msg  *types.MsgSetOrderBookLimits
+		err  error
+	}{
+		{
+			name: "nil curator",
+			msg:  types.NewMsgSetOrderBookLimits("book", 1, 1, nil),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty order book",
+			msg:  types.NewMsgSetOrderBookLimits("", 1, 1, curator),
+			err:  types.ErrOrderBookNotFound,
+		},
+		{
+			name: "negative tick size",
+			msg:  types.NewMsgSetOrderBookLimits("book", -1, 1, curator),
+			err:  types.ErrInvalidTickSize,
+		},
+		{
+			name: "negative min order amount",
+			msg:  types.NewMsgSetOrderBookLimits("book", 1, -1, curator),
+			err:  types.ErrInvalidMinOrderAmount,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			require.Error(t, err)
+			require.True(t, errors.Is(err, tt.err), err.Error())
+		})
+	}
+
+	require.NoError(t, types.NewMsgSetOrderBookLimits("book", 0, 0, curator).ValidateBasic())
+}
+
+func TestMsgTransferOrderBookCuratorship_ValidateBasic(t *testing.T) {
+	curator := sdk.AccAddress("curator_____________")
+
+	err := types.NewMsgTransferOrderBookCuratorship("book", nil, curator).ValidateBasic()
+	require.True(t, errors.Is(err, sdkerrors.ErrInvalidAddress))
+
+	require.NoError(t, types.NewMsgTransferOrderBookCuratorship("book", curator, curator).ValidateBasic())
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter keys
+var (
+	ParamStoreKeyMakerFeeRate    = []byte("makerfeerate")
+	ParamStoreKeyTakerFeeRate    = []byte("takerfeerate")
+	ParamStoreKeyCuratorFeeShare = []byte("curatorfeeshare")
+	ParamStoreKeyOrderBookFees   = []byte("orderbookfees")
+	ParamStoreKeyTradeRetention  = []byte("traderetention")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default dex parameters
+func DefaultParams() Params {
+	return Params{
+		MakerFeeRate:    sdk.NewDecWithPrec(1, 3), // 0.1%
+		TakerFeeRate:    sdk.NewDecWithPrec(2, 3), // 0.2%
+		CuratorFeeShare: sdk.NewDecWithPrec(5, 1), // 50%
+		OrderBookFees:   []OrderBookFee{},
+		TradeRetention:  60 * 60 * 24 * 30, // 30 days
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMakerFeeRate, &p.MakerFeeRate, validateFeeRate),
+		paramtypes.NewParamSetPair(ParamStoreKeyTakerFeeRate, &p.TakerFeeRate, validateFeeRate),
+		paramtypes.NewParamSetPair(ParamStoreKeyCuratorFeeShare, &p.CuratorFeeShare, validateFeeRate),
+		paramtypes.NewParamSetPair(ParamStoreKeyOrderBookFees, &p.OrderBookFees, validateOrderBookFees),
+		paramtypes.NewParamSetPair(ParamStoreKeyTradeRetention, &p.TradeRetention, validateTradeRetention),
+	}
+}
+
+// Validate performs basic validation on dex parameters.
+func (p Params) Validate() error {
+	if err := validateFeeRate(p.MakerFeeRate); err != nil {
+		return fmt.Errorf("invalid maker fee rate: %w", err)
+	}
+	if err := validateFeeRate(p.TakerFeeRate); err != nil {
+		return fmt.Errorf("invalid taker fee rate: %w", err)
+	}
+	if err := validateFeeRate(p.CuratorFeeShare); err != nil {
+		return fmt.Errorf("invalid curator fee share: %w", err)
+	}
+
+	if err := validateTradeRetention(p.TradeRetention); err != nil {
+		return fmt.Errorf("invalid trade retention: %w", err)
+	}
+
+	return validateOrderBookFees(p.OrderBookFees)
+}
+
+// GetFeeRates returns the maker and taker fee rates of an order book.
+func (p Params) GetFeeRates(orderBookID string) (makerFeeRate sdk.Dec, takerFeeRate sdk.Dec) {
+	for _, fee := range p.OrderBookFees {
+		if fee.OrderBookID == orderBookID {
+			return fee.MakerFeeRate, fee.TakerFeeRate
+		}
+	}
+
+	return p.MakerFeeRate, p.TakerFeeRate
+}
+
+func validateFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("rate must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("rate must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateOrderBookFees(i interface{}) error {
+	v, ok := i.([]OrderBookFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, fee := range v {
+		if fee.OrderBookID == "" {
+			return fmt.Errorf("order book id not set")
+		}
+		if seen[fee.OrderBookID] {
+			return fmt.Errorf("duplicate fees for order book %s", fee.OrderBookID)
+		}
+		seen[fee.OrderBookID] = true
+
+		if err := validateFeeRate(fee.MakerFeeRate); err != nil {
+			return err
+		}
+		if err := validateFeeRate(fee.TakerFeeRate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateTradeRetention(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("trade retention must be positive: %d", v)
+	}
+
+	return nil
+}
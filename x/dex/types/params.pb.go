@@ -0,0 +1,797 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the trading fees of the dex module. Fee rates are charged on
+// what each side of a fill receives, the maker being the order placed first.
+type Params struct {
+	MakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate" yaml:"maker_fee_rate"`
+	TakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate" yaml:"taker_fee_rate"`
+	// curator_fee_share is the part of the fees paid to the curator of the order
+	// book, the rest goes to the fee collector.
+	CuratorFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=curator_fee_share,json=curatorFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"curator_fee_share" yaml:"curator_fee_share"`
+	// order_book_fees override the maker and taker fee rates of single order books.
+	OrderBookFees []OrderBookFee `protobuf:"bytes,4,rep,name=order_book_fees,json=orderBookFees,proto3" json:"order_book_fees" yaml:"order_book_fees"`
+	// trade_retention is how long, in seconds, trades are kept in the history of
+	// their order book. Zero keeps them forever.
+	TradeRetention int64 `protobuf:"varint,5,opt,name=trade_retention,json=tradeRetention,proto3" json:"trade_retention,omitempty" yaml:"trade_retention"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8679b07c520418a1, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetOrderBookFees() []OrderBookFee {
+	if m != nil {
+		return m.OrderBookFees
+	}
+	return nil
+}
+
+func (m *Params) GetTradeRetention() int64 {
+	if m != nil {
+		return m.TradeRetention
+	}
+	return 0
+}
+
+// OrderBookFee defines the maker and taker fee rates of an order book.
+type OrderBookFee struct {
+	OrderBookID  string                                 `protobuf:"bytes,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty" yaml:"order_book_id"`
+	MakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate" yaml:"maker_fee_rate"`
+	TakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate" yaml:"taker_fee_rate"`
+}
+
+func (m *OrderBookFee) Reset()         { *m = OrderBookFee{} }
+func (m *OrderBookFee) String() string { return proto.CompactTextString(m) }
+func (*OrderBookFee) ProtoMessage()    {}
+func (*OrderBookFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8679b07c520418a1, []int{1}
+}
+func (m *OrderBookFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookFee.Merge(m, src)
+}
+func (m *OrderBookFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookFee proto.InternalMessageInfo
+
+func (m *OrderBookFee) GetOrderBookID() string {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "kira.dex.Params")
+	proto.RegisterType((*OrderBookFee)(nil), "kira.dex.OrderBookFee")
+}
+
+func init() { proto.RegisterFile("params.proto", fileDescriptor_8679b07c520418a1) }
+
+var fileDescriptor_8679b07c520418a1 = []byte{
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xb1, 0x6e, 0xd3, 0x40,
+	0x18, 0xc7, 0xed, 0x38, 0x54, 0x70, 0x49, 0x1b, 0x61, 0x95, 0xca, 0xea, 0x60, 0x47, 0x27, 0x01,
+	0x59, 0xb0, 0x25, 0xd8, 0xba, 0x20, 0xb9, 0x55, 0x50, 0xe9, 0x00, 0x32, 0x1b, 0x03, 0xd6, 0xc5,
+	0xf7, 0x91, 0x5a, 0xc6, 0xfd, 0xa2, 0xbb, 0x0b, 0x4a, 0x1e, 0x81, 0x8d, 0x91, 0x91, 0xd7, 0xe0,
+	0x0d, 0x32, 0x66, 0x44, 0x0c, 0x16, 0x72, 0xde, 0x20, 0x4f, 0x80, 0x6c, 0x27, 0xc1, 0x49, 0x58,
+	0x10, 0xa2, 0x93, 0xad, 0xff, 0xfd, 0xbf, 0xef, 0xf7, 0x97, 0xee, 0x7f, 0xa4, 0x3d, 0x62, 0x82,
+	0xa5, 0xd2, 0x1d, 0x09, 0x54, 0x68, 0xde, 0x4d, 0x62, 0xc1, 0x5c, 0x0e, 0x93, 0xd3, 0xe3, 0x21,
+	0x0e, 0xb1, 0x14, 0xbd, 0xe2, 0xaf, 0x3a, 0xa7, 0x9f, 0x9a, 0xe4, 0xe0, 0x75, 0x39, 0x60, 0xa6,
+	0xe4, 0x28, 0x65, 0x09, 0x88, 0xf0, 0x3d, 0x40, 0x28, 0x98, 0x02, 0x4b, 0xef, 0xea, 0xbd, 0x7b,
+	0xfe, 0x8b, 0x59, 0xe6, 0x68, 0x3f, 0x32, 0xe7, 0xd1, 0x30, 0x56, 0xd7, 0xe3, 0x81, 0x1b, 0x61,
+	0xea, 0x45, 0x28, 0x53, 0x94, 0xab, 0xcf, 0x13, 0xc9, 0x13, 0x4f, 0x4d, 0x47, 0x20, 0xdd, 0x0b,
+	0x88, 0x96, 0x99, 0xf3, 0x60, 0xca, 0xd2, 0x0f, 0x67, 0x74, 0x7b, 0x1b, 0x0d, 0xda, 0xa5, 0xd0,
+	0x07, 0x08, 0x98, 0x82, 0x02, 0xa7, 0xb6, 0x71, 0x8d, 0x7f, 0xc3, 0xa9, 0x5d, 0x9c, 0xaa, 0xe3,
+	0x3e, 0x92, 0xfb, 0xd1, 0x58, 0x30, 0x85, 0x95, 0x45, 0x5e, 0x33, 0x01, 0x96, 0x51, 0x12, 0x5f,
+	0xfe, 0x35, 0xd1, 0xaa, 0x88, 0x7b, 0x0b, 0x69, 0xd0, 0x59, 0x69, 0x7d, 0x80, 0x37, 0x85, 0x62,
+	0xbe, 0x23, 0x1d, 0x14, 0x1c, 0x44, 0x38, 0x40, 0x4c, 0x0a, 0xa7, 0xb4, 0x9a, 0x5d, 0xa3, 0xd7,
+	0x7a, 0x7a, 0xe2, 0xae, 0xaf, 0xc6, 0x7d, 0x55, 0x18, 0x7c, 0xc4, 0xa4, 0x0f, 0xe0, 0xdb, 0x45,
+	0x9a, 0x65, 0xe6, 0x9c, 0x54, 0x8c, 0x9d, 0x61, 0x1a, 0x1c, 0x62, 0xcd, 0x2d, 0xcd, 0x73, 0xd2,
+	0x51, 0x82, 0x71, 0x08, 0x05, 0x28, 0xb8, 0x51, 0x31, 0xde, 0x58, 0x77, 0xba, 0x7a, 0xcf, 0xf0,
+	0x4f, 0x7f, 0xef, 0xd8, 0x31, 0xd0, 0xe0, 0xa8, 0x54, 0x82, 0xb5, 0x70, 0xd6, 0xfc, 0xf2, 0xd5,
+	0xd1, 0xe8, 0xb7, 0x06, 0x69, 0xd7, 0xa3, 0x98, 0x57, 0xe4, 0xb0, 0x86, 0x8f, 0xf9, 0xaa, 0x10,
+	0x8f, 0xf3, 0xcc, 0x69, 0x6d, 0x8c, 0x97, 0x17, 0xcb, 0xcc, 0x39, 0xde, 0x0b, 0x1b, 0x73, 0x1a,
+	0xb4, 0x36, 0x51, 0x2f, 0xf9, 0x1f, 0xea, 0xd5, 0xb8, 0xdd, 0x7a, 0x19, 0xff, 0xb1, 0x5e, 0xfe,
+	0xf3, 0x59, 0x6e, 0xeb, 0xf3, 0xdc, 0xd6, 0x7f, 0xe6, 0xb6, 0xfe, 0x79, 0x61, 0x6b, 0xf3, 0x85,
+	0xad, 0x7d, 0x5f, 0xd8, 0xda, 0xdb, 0x87, 0x35, 0xd0, 0x55, 0x2c, 0xd8, 0x39, 0x0a, 0xf0, 0x24,
+	0x24, 0x2c, 0xf6, 0x26, 0x1e, 0x87, 0x49, 0xc5, 0x1a, 0x1c, 0x94, 0xef, 0xf1, 0xd9, 0xaf, 0x01,
+	0x00, 0x5e, 0x9f, 0x08, 0xac, 0xbf, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TradeRetention))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.OrderBookFees) > 0 {
+		for iNdEx := len(m.OrderBookFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBookFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.CuratorFeeShare.Size()
+		i -= size
+		if _, err := m.CuratorFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.CuratorFeeShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.OrderBookFees) > 0 {
+		for _, e := range m.OrderBookFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.TradeRetention != 0 {
+		n += 1 + sovParams(uint64(m.TradeRetention))
+	}
+	return n
+}
+
+func (m *OrderBookFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CuratorFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CuratorFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookFees = append(m.OrderBookFees, OrderBookFee{})
+			if err := m.OrderBookFees[len(m.OrderBookFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeRetention", wireType)
+			}
+			m.TradeRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
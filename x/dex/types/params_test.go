@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/KiraCore/sekai/x/dex/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.TakerFeeRate = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.CuratorFeeShare = sdk.NewDec(2)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.TradeRetention = -1
+	require.Error(t, params.Validate())
+
+	params.TradeRetention = 0
+	require.NoError(t, params.Validate())
+
+	fee := types.OrderBookFee{OrderBookID: "book", MakerFeeRate: sdk.ZeroDec(), TakerFeeRate: sdk.ZeroDec()}
+	params = types.DefaultParams()
+	params.OrderBookFees = []types.OrderBookFee{fee, fee}
+	require.Error(t, params.Validate())
+
+	params.OrderBookFees = []types.OrderBookFee{fee}
+	require.NoError(t, params.Validate())
+
+	makerFeeRate, takerFeeRate := params.GetFeeRates("book")
+	require.True(t, makerFeeRate.IsZero())
+	require.True(t, takerFeeRate.IsZero())
+
+	makerFeeRate, takerFeeRate = params.GetFeeRates("other")
+	require.Equal(t, params.MakerFeeRate, makerFeeRate)
+	require.Equal(t, params.TakerFeeRate, takerFeeRate)
+}
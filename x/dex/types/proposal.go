@@ -0,0 +1,105 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeDelistOrderBook   = "DelistOrderBook"
+	ProposalTypeReassignOrderBook = "ReassignOrderBook"
+)
+
+var (
+	_ govtypes.Content = &DelistOrderBookProposal{}
+	_ govtypes.Content = &ReassignOrderBookProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeDelistOrderBook)
+	govtypes.RegisterProposalTypeCodec(&DelistOrderBookProposal{}, "kiraHub/DelistOrderBookProposal")
+	govtypes.RegisterProposalType(ProposalTypeReassignOrderBook)
+	govtypes.RegisterProposalTypeCodec(&ReassignOrderBookProposal{}, "kiraHub/ReassignOrderBookProposal")
+}
+
+func NewDelistOrderBookProposal(title, description, orderBookID string) *DelistOrderBookProposal {
+	return &DelistOrderBookProposal{
+		Title:       title,
+		Description: description,
+		OrderBookID: orderBookID,
+	}
+}
+
+func (p *DelistOrderBookProposal) GetTitle() string { return p.Title }
+
+func (p *DelistOrderBookProposal) GetDescription() string { return p.Description }
+
+func (p *DelistOrderBookProposal) ProposalRoute() string { return RouterKey }
+
+func (p *DelistOrderBookProposal) ProposalType() string { return ProposalTypeDelistOrderBook }
+
+func (p *DelistOrderBookProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.OrderBookID == "" {
+		return sdkerrors.Wrap(ErrOrderBookNotFound, "order book id not set")
+	}
+
+	return nil
+}
+
+func (p DelistOrderBookProposal) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Delist Order Book Proposal:
+  Title:         %s
+  Description:   %s
+  Order Book ID: %s
+`, p.Title, p.Description, p.OrderBookID))
+}
+
+func NewReassignOrderBookProposal(title, description, orderBookID string, newCurator sdk.AccAddress) *ReassignOrderBookProposal {
+	return &ReassignOrderBookProposal{
+		Title:       title,
+		Description: description,
+		OrderBookID: orderBookID,
+		NewCurator:  newCurator,
+	}
+}
+
+func (p *ReassignOrderBookProposal) GetTitle() string { return p.Title }
+
+func (p *ReassignOrderBookProposal) GetDescription() string { return p.Description }
+
+func (p *ReassignOrderBookProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ReassignOrderBookProposal) ProposalType() string { return ProposalTypeReassignOrderBook }
+
+func (p *ReassignOrderBookProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.OrderBookID == "" {
+		return sdkerrors.Wrap(ErrOrderBookNotFound, "order book id not set")
+	}
+
+	if p.NewCurator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "new curator not set")
+	}
+
+	return nil
+}
+
+func (p ReassignOrderBookProposal) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Reassign Order Book Proposal:
+  Title:         %s
+  Description:   %s
+  Order Book ID: %s
+  New Curator:   %s
+`, p.Title, p.Description, p.OrderBookID, p.NewCurator))
+}
@@ -0,0 +1,712 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DelistOrderBookProposal removes an order book from the chain, refunding
+// every limit order resting on it.
+type DelistOrderBookProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	OrderBookID string `protobuf:"bytes,3,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+}
+
+func (m *DelistOrderBookProposal) Reset()      { *m = DelistOrderBookProposal{} }
+func (*DelistOrderBookProposal) ProtoMessage() {}
+func (*DelistOrderBookProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{0}
+}
+func (m *DelistOrderBookProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelistOrderBookProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelistOrderBookProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelistOrderBookProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelistOrderBookProposal.Merge(m, src)
+}
+func (m *DelistOrderBookProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelistOrderBookProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelistOrderBookProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelistOrderBookProposal proto.InternalMessageInfo
+
+// ReassignOrderBookProposal hands the curatorship of an order book to a new curator.
+type ReassignOrderBookProposal struct {
+	Title       string                                        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	OrderBookID string                                        `protobuf:"bytes,3,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	NewCurator  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=new_curator,json=newCurator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"new_curator,omitempty" yaml:"new_curator"`
+}
+
+func (m *ReassignOrderBookProposal) Reset()      { *m = ReassignOrderBookProposal{} }
+func (*ReassignOrderBookProposal) ProtoMessage() {}
+func (*ReassignOrderBookProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3ac5ce23bf32d05, []int{1}
+}
+func (m *ReassignOrderBookProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReassignOrderBookProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReassignOrderBookProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReassignOrderBookProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReassignOrderBookProposal.Merge(m, src)
+}
+func (m *ReassignOrderBookProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReassignOrderBookProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReassignOrderBookProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReassignOrderBookProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*DelistOrderBookProposal)(nil), "kira.dex.DelistOrderBookProposal")
+	proto.RegisterType((*ReassignOrderBookProposal)(nil), "kira.dex.ReassignOrderBookProposal")
+}
+
+func init() { proto.RegisterFile("proposal.proto", fileDescriptor_c3ac5ce23bf32d05) }
+
+var fileDescriptor_c3ac5ce23bf32d05 = []byte{
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x51, 0x3d, 0x6b, 0xc2, 0x50,
+	0x14, 0xcd, 0xeb, 0x17, 0xf6, 0xa5, 0x1f, 0x10, 0x84, 0xa6, 0x1d, 0x12, 0x09, 0x14, 0x5c, 0x4c,
+	0x06, 0x37, 0x97, 0x62, 0x14, 0x4a, 0xe9, 0xd0, 0x92, 0xb1, 0x8b, 0xc4, 0xbc, 0x4b, 0x7c, 0x24,
+	0xe6, 0x86, 0xf7, 0x9e, 0xa8, 0xff, 0xa0, 0xa3, 0x63, 0x47, 0x7f, 0x4e, 0x47, 0xc7, 0x4e, 0x52,
+	0xe2, 0x3f, 0xe8, 0x58, 0x3a, 0x14, 0x8d, 0x58, 0xff, 0x41, 0xa7, 0xf7, 0xce, 0x39, 0xf7, 0x9e,
+	0x73, 0x2f, 0x97, 0x5e, 0xe4, 0x02, 0x73, 0x94, 0x61, 0xea, 0xe6, 0x02, 0x15, 0x1a, 0x95, 0x84,
+	0x8b, 0xd0, 0x65, 0x30, 0xb9, 0xa9, 0xc6, 0x18, 0xe3, 0x86, 0xf4, 0xd6, 0xbf, 0x52, 0x77, 0x66,
+	0x84, 0x5e, 0x75, 0x21, 0xe5, 0x52, 0x3d, 0x09, 0x06, 0xc2, 0x47, 0x4c, 0x9e, 0xb7, 0x0e, 0x46,
+	0x95, 0x1e, 0x2b, 0xae, 0x52, 0x30, 0x49, 0x8d, 0xd4, 0x4f, 0x83, 0x12, 0x18, 0x35, 0xaa, 0x33,
+	0x90, 0x91, 0xe0, 0xb9, 0xe2, 0x98, 0x99, 0x07, 0x1b, 0x6d, 0x9f, 0x32, 0x9a, 0xf4, 0x1c, 0xd7,
+	0x66, 0xbd, 0x3e, 0x62, 0xd2, 0xe3, 0xcc, 0x3c, 0x5c, 0xd7, 0xf8, 0x97, 0xc5, 0xd2, 0xd6, 0x77,
+	0x29, 0x0f, 0xdd, 0x40, 0xc7, 0x1d, 0x60, 0xad, 0xca, 0xeb, 0xdc, 0xd6, 0xde, 0xe6, 0xb6, 0xe6,
+	0xfc, 0x10, 0x7a, 0x1d, 0x40, 0x28, 0x25, 0x8f, 0xb3, 0xff, 0x1d, 0xca, 0x18, 0x50, 0x3d, 0x83,
+	0x71, 0x2f, 0x1a, 0x89, 0x50, 0xa1, 0x30, 0x8f, 0x6a, 0xa4, 0x7e, 0xe6, 0xdf, 0x7f, 0x2d, 0x6d,
+	0x63, 0x1a, 0x0e, 0xd3, 0x96, 0xb3, 0x27, 0x3a, 0xdf, 0x4b, 0xbb, 0x11, 0x73, 0x35, 0x18, 0xf5,
+	0xdd, 0x08, 0x87, 0x5e, 0x84, 0x72, 0x88, 0x72, 0xfb, 0x34, 0x24, 0x4b, 0x3c, 0x35, 0xcd, 0x41,
+	0xba, 0xed, 0x28, 0x6a, 0x33, 0x26, 0x40, 0xca, 0x80, 0x66, 0x30, 0xee, 0x94, 0xdd, 0x7f, 0xeb,
+	0xfb, 0x77, 0xef, 0x85, 0x45, 0x16, 0x85, 0x45, 0x3e, 0x0b, 0x8b, 0xcc, 0x56, 0x96, 0xb6, 0x58,
+	0x59, 0xda, 0xc7, 0xca, 0xd2, 0x5e, 0x6e, 0xf7, 0xec, 0x1f, 0xb9, 0x08, 0x3b, 0x28, 0xc0, 0x93,
+	0x90, 0x84, 0xdc, 0x9b, 0x78, 0x0c, 0x26, 0x65, 0x42, 0xff, 0x64, 0x73, 0xd9, 0xe6, 0xef, 0x00,
+	0x03, 0x39, 0x87, 0x15, 0x0b, 0x02, 0x00, 0x00,
+}
+
+func (m *DelistOrderBookProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelistOrderBookProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelistOrderBookProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReassignOrderBookProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReassignOrderBookProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReassignOrderBookProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewCurator) > 0 {
+		i -= len(m.NewCurator)
+		copy(dAtA[i:], m.NewCurator)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.NewCurator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DelistOrderBookProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *ReassignOrderBookProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.NewCurator)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelistOrderBookProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelistOrderBookProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelistOrderBookProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReassignOrderBookProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReassignOrderBookProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReassignOrderBookProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCurator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewCurator = append(m.NewCurator[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewCurator == nil {
+				m.NewCurator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
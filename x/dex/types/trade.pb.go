@@ -0,0 +1,800 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: trade.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Trade records a fill between a bid and an ask of an order book.
+type Trade struct {
+	Index       uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	OrderBookID string `protobuf:"bytes,2,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	BuyOrderID  string `protobuf:"bytes,3,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
+	SellOrderID string `protobuf:"bytes,4,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	Amount      int64  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price       int64  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	Time        int64  `protobuf:"varint,7,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *Trade) Reset()         { *m = Trade{} }
+func (m *Trade) String() string { return proto.CompactTextString(m) }
+func (*Trade) ProtoMessage()    {}
+func (*Trade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{0}
+}
+func (m *Trade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Trade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trade.Merge(m, src)
+}
+func (m *Trade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trade proto.InternalMessageInfo
+
+func (m *Trade) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Trade) GetOrderBookID() string {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return ""
+}
+
+func (m *Trade) GetBuyOrderID() string {
+	if m != nil {
+		return m.BuyOrderID
+	}
+	return ""
+}
+
+func (m *Trade) GetSellOrderID() string {
+	if m != nil {
+		return m.SellOrderID
+	}
+	return ""
+}
+
+func (m *Trade) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Trade) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *Trade) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// PriceLevel aggregates the limit orders resting at the same price on one side of an order book.
+type PriceLevel struct {
+	Price  int64  `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	Amount int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Orders uint32 `protobuf:"varint,3,opt,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (m *PriceLevel) Reset()         { *m = PriceLevel{} }
+func (m *PriceLevel) String() string { return proto.CompactTextString(m) }
+func (*PriceLevel) ProtoMessage()    {}
+func (*PriceLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{1}
+}
+func (m *PriceLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceLevel.Merge(m, src)
+}
+func (m *PriceLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceLevel proto.InternalMessageInfo
+
+func (m *PriceLevel) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *PriceLevel) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PriceLevel) GetOrders() uint32 {
+	if m != nil {
+		return m.Orders
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Trade)(nil), "kira.dex.Trade")
+	proto.RegisterType((*PriceLevel)(nil), "kira.dex.PriceLevel")
+}
+
+func init() { proto.RegisterFile("trade.proto", fileDescriptor_ee944bd90e8a0312) }
+
+var fileDescriptor_ee944bd90e8a0312 = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0xbb, 0xfd, 0x13, 0x75, 0x63, 0x15, 0x96, 0x52, 0x82, 0x87, 0xb4, 0x14, 0x84, 0x9e,
+	0x1a, 0xa1, 0x0f, 0x20, 0xc4, 0x5e, 0x8a, 0x82, 0x12, 0x3d, 0x79, 0x29, 0x49, 0x77, 0xa8, 0x4b,
+	0xd2, 0x4e, 0xd9, 0x24, 0x92, 0xbe, 0x85, 0x8f, 0xe5, 0xb1, 0x47, 0x4f, 0x45, 0x92, 0xc7, 0xf0,
+	0x22, 0xbb, 0x69, 0x1b, 0xbd, 0xcd, 0x6f, 0xbe, 0xfc, 0xf8, 0x32, 0x2c, 0x35, 0x13, 0xe9, 0x73,
+	0x18, 0xad, 0x25, 0x26, 0xc8, 0x4e, 0x43, 0x21, 0xfd, 0x11, 0x87, 0xec, 0xaa, 0xb3, 0xc0, 0x05,
+	0xea, 0xa5, 0xa3, 0xa6, 0x32, 0x1f, 0xfc, 0x10, 0xda, 0x7a, 0x51, 0xdf, 0xb3, 0x0e, 0x6d, 0x89,
+	0x15, 0x87, 0xcc, 0x22, 0x7d, 0x32, 0x6c, 0x7a, 0x25, 0xb0, 0x31, 0x6d, 0xa3, 0xe4, 0x20, 0x67,
+	0x01, 0x62, 0x38, 0x13, 0xdc, 0xaa, 0xf7, 0xc9, 0xf0, 0xcc, 0xbd, 0xcc, 0x77, 0x3d, 0xf3, 0x51,
+	0x05, 0x2e, 0x62, 0x38, 0x9d, 0x78, 0x26, 0x1e, 0x81, 0xb3, 0x1b, 0x7a, 0x1e, 0xa4, 0x9b, 0x59,
+	0x29, 0x0a, 0x6e, 0x35, 0xb4, 0x73, 0x91, 0xef, 0x7a, 0xd4, 0x4d, 0x37, 0x5a, 0x9b, 0x4e, 0x3c,
+	0x1a, 0x1c, 0x66, 0xae, 0x6a, 0x62, 0x88, 0xa2, 0x4a, 0x69, 0x56, 0x35, 0xcf, 0x10, 0x45, 0x07,
+	0xc7, 0x8c, 0x8f, 0xc0, 0x59, 0x97, 0x1a, 0xfe, 0x12, 0xd3, 0x55, 0x62, 0xb5, 0xfa, 0x64, 0xd8,
+	0xf0, 0xf6, 0xa4, 0x2e, 0x59, 0x4b, 0x31, 0x07, 0xcb, 0xd0, 0xeb, 0x12, 0x18, 0xa3, 0xcd, 0x44,
+	0x2c, 0xc1, 0x3a, 0xd1, 0x4b, 0x3d, 0x0f, 0x3c, 0x4a, 0x9f, 0x54, 0xf8, 0x00, 0xef, 0x10, 0x55,
+	0x1e, 0xf9, 0xeb, 0x55, 0x2d, 0xf5, 0x7f, 0x2d, 0x5d, 0x6a, 0xe8, 0xbf, 0x8d, 0xf5, 0x79, 0x6d,
+	0x6f, 0x4f, 0xee, 0xed, 0x67, 0x6e, 0x93, 0x6d, 0x6e, 0x93, 0xef, 0xdc, 0x26, 0x1f, 0x85, 0x5d,
+	0xdb, 0x16, 0x76, 0xed, 0xab, 0xb0, 0x6b, 0xaf, 0xd7, 0x0b, 0x91, 0xbc, 0xa5, 0xc1, 0x68, 0x8e,
+	0x4b, 0xe7, 0x5e, 0x48, 0xff, 0x0e, 0x25, 0x38, 0x31, 0x84, 0xbe, 0x70, 0x32, 0x87, 0x43, 0xe6,
+	0x24, 0x9b, 0x35, 0xc4, 0x81, 0xa1, 0x5f, 0x66, 0xfc, 0x3b, 0x00, 0x96, 0x7b, 0x12, 0xd0, 0xc8,
+	0x01, 0x00, 0x00,
+}
+
+func (m *Trade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Price != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SellOrderID) > 0 {
+		i -= len(m.SellOrderID)
+		copy(dAtA[i:], m.SellOrderID)
+		i = encodeVarintTrade(dAtA, i, uint64(len(m.SellOrderID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BuyOrderID) > 0 {
+		i -= len(m.BuyOrderID)
+		copy(dAtA[i:], m.BuyOrderID)
+		i = encodeVarintTrade(dAtA, i, uint64(len(m.BuyOrderID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderBookID) > 0 {
+		i -= len(m.OrderBookID)
+		copy(dAtA[i:], m.OrderBookID)
+		i = encodeVarintTrade(dAtA, i, uint64(len(m.OrderBookID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Orders != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Orders))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Price != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTrade(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTrade(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Trade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTrade(uint64(m.Index))
+	}
+	l = len(m.OrderBookID)
+	if l > 0 {
+		n += 1 + l + sovTrade(uint64(l))
+	}
+	l = len(m.BuyOrderID)
+	if l > 0 {
+		n += 1 + l + sovTrade(uint64(l))
+	}
+	l = len(m.SellOrderID)
+	if l > 0 {
+		n += 1 + l + sovTrade(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTrade(uint64(m.Amount))
+	}
+	if m.Price != 0 {
+		n += 1 + sovTrade(uint64(m.Price))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTrade(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *PriceLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Price != 0 {
+		n += 1 + sovTrade(uint64(m.Price))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTrade(uint64(m.Amount))
+	}
+	if m.Orders != 0 {
+		n += 1 + sovTrade(uint64(m.Orders))
+	}
+	return n
+}
+
+func sovTrade(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTrade(x uint64) (n int) {
+	return sovTrade(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Trade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			m.Orders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Orders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTrade(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTrade
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTrade
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTrade
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTrade
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTrade        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTrade          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTrade = fmt.Errorf("proto: unexpected end of group")
+)
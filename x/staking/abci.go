@@ -0,0 +1,24 @@
+package staking
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KiraCore/sekai/x/staking/keeper"
+)
+
+// BeginBlocker tracks which validators signed the last block and jails the
+// ones that have been down for too long.
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
+	for _, vote := range req.LastCommitInfo.GetVotes() {
+		k.HandleValidatorSignature(ctx, vote.Validator.Address, vote.SignedLastBlock)
+	}
+}
+
+// EndBlocker removes the exiting validators whose unbonding time is over and
+// returns the changes to the Tendermint validator set.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	k.CompleteUnbondings(ctx)
+
+	return k.ApplyValidatorSetUpdates(ctx)
+}
@@ -0,0 +1,129 @@
+package staking_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/staking"
+	customtypes "github.com/KiraCore/sekai/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBeginBlocker_JailsValidatorsForDowntime(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	params := app.CustomStakingKeeper.GetParams(ctx)
+	params.SignedBlocksWindow = 10
+	params.MinSignedPerWindow = types.NewDecWithPrec(5, 1)
+	params.DowntimeJailDuration = 60
+	app.CustomStakingKeeper.SetParams(ctx, params)
+
+	pubKey1, pubKey2 := ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()
+	online, err := customtypes.NewValidator("online", "", "", "", types.NewDecWithPrec(1, 1), types.ValAddress(pubKey1.Address()), pubKey1)
+	require.NoError(t, err)
+	offline, err := customtypes.NewValidator("offline", "", "", "", types.NewDecWithPrec(1, 1), types.ValAddress(pubKey2.Address()), pubKey2)
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, online)
+	app.CustomStakingKeeper.AddValidator(ctx, offline)
+
+	beginBlock := func(height int64) {
+		ctx = ctx.WithBlockHeight(height)
+		staking.BeginBlocker(ctx, abci.RequestBeginBlock{
+			LastCommitInfo: abci.LastCommitInfo{
+				Votes: []abci.VoteInfo{
+					{Validator: abci.Validator{Address: pubKey1.Address(), Power: 1}, SignedLastBlock: true},
+					{Validator: abci.Validator{Address: pubKey2.Address(), Power: 1}, SignedLastBlock: false},
+				},
+			},
+		}, app.CustomStakingKeeper)
+	}
+
+	// Validators are not judged before their first full window.
+	for height := int64(1); height < 11; height++ {
+		beginBlock(height)
+	}
+	require.Equal(t, customtypes.Active, app.CustomStakingKeeper.GetValidator(ctx, offline.ValKey).Status)
+
+	info, found := app.CustomStakingKeeper.GetValidatorSigningInfo(ctx, offline.ValKey)
+	require.True(t, found)
+	require.Equal(t, int64(10), info.MissedBlocksCounter)
+
+	beginBlock(11)
+	jailed := app.CustomStakingKeeper.GetValidator(ctx, offline.ValKey)
+	require.Equal(t, customtypes.Jailed, jailed.Status)
+	require.Equal(t, int64(1060), jailed.JailedUntil)
+	require.Equal(t, int64(0), jailed.ConsensusPower())
+	require.Equal(t, customtypes.Active, app.CustomStakingKeeper.GetValidator(ctx, online.ValKey).Status)
+
+	// Jailing forgets the downtime.
+	_, found = app.CustomStakingKeeper.GetValidatorSigningInfo(ctx, offline.ValKey)
+	require.False(t, found)
+
+	handler := staking.NewHandler(app.CustomStakingKeeper)
+	_, err = handler(ctx, customtypes.NewMsgActivate(offline.ValKey))
+	require.Error(t, err)
+
+	ctx = ctx.WithBlockTime(time.Unix(1060, 0))
+	_, err = handler(ctx, customtypes.NewMsgActivate(offline.ValKey))
+	require.NoError(t, err)
+
+	activated := app.CustomStakingKeeper.GetValidator(ctx, offline.ValKey)
+	require.Equal(t, customtypes.Active, activated.Status)
+	require.Equal(t, int64(0), activated.JailedUntil)
+}
+
+func TestEndBlocker_RemovesExitedValidators(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	params := app.CustomStakingKeeper.GetParams(ctx)
+	params.UnbondingTime = 100
+	app.CustomStakingKeeper.SetParams(ctx, params)
+
+	handler := staking.NewHandler(app.CustomStakingKeeper)
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	valKey := types.ValAddress(pubKey.Address())
+	claim, err := customtypes.NewMsgClaimValidator("exiting", "", "", "", types.NewDecWithPrec(1, 1), valKey, pubKey)
+	require.NoError(t, err)
+	_, err = handler(ctx, claim)
+	require.NoError(t, err)
+	require.Len(t, staking.EndBlocker(ctx, app.CustomStakingKeeper), 1)
+
+	_, err = handler(ctx, customtypes.NewMsgExitValidator(valKey))
+	require.NoError(t, err)
+
+	_, err = handler(ctx, customtypes.NewMsgExitValidator(valKey))
+	require.True(t, errors.Is(err, customtypes.ErrValidatorExiting))
+
+	// The validator leaves the Tendermint set right away.
+	updates := staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: updates[0].PubKey, Power: 0}}, updates)
+
+	exiting := app.CustomStakingKeeper.GetValidator(ctx, valKey)
+	require.Equal(t, customtypes.Exiting, exiting.Status)
+	require.Equal(t, int64(1100), exiting.UnbondingUntil)
+
+	// It can not claim a seat again while unbonding.
+	ctx = ctx.WithBlockTime(time.Unix(1099, 0))
+	require.Empty(t, staking.EndBlocker(ctx, app.CustomStakingKeeper))
+	_, err = handler(ctx, claim)
+	require.True(t, errors.Is(err, customtypes.ErrValidatorExiting))
+
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	require.Empty(t, staking.EndBlocker(ctx, app.CustomStakingKeeper))
+	require.False(t, app.CustomStakingKeeper.HasValidator(ctx, valKey))
+	require.False(t, app.CustomStakingKeeper.HasValidatorMoniker(ctx, "exiting"))
+
+	_, err = handler(ctx, claim)
+	require.NoError(t, err)
+	require.Len(t, staking.EndBlocker(ctx, app.CustomStakingKeeper), 1)
+}
@@ -9,6 +9,7 @@ import (
 	cumstomtypes "github.com/KiraCore/sekai/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -46,6 +47,9 @@ func GenTxClaimCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, ge
 			}
 
 			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(cdc, config.GenesisFile())
+			if err != nil {
+				return errors.Wrap(err, "failed to read genesis file")
+			}
 
 			name := args[0]
 			key, err := clientCtx.Keyring.Key(name)
@@ -84,15 +88,10 @@ func GenTxClaimCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, ge
 				return errors.Wrap(err, "failed to create new validator")
 			}
 
-			var stakingGenesisState cumstomtypes.GenesisState
-			stakingGenesisState.Validators = append(stakingGenesisState.Validators, validator)
-
-			bzStakingGen, err := cdc.MarshalJSON(&stakingGenesisState)
-			if err != nil {
-				return fmt.Errorf("failed to marshal staking genesis state: %w", err)
+			if err := AddGenesisValidator(cdc, appState, validator); err != nil {
+				return err
 			}
 
-			appState[cumstomtypes.ModuleName] = bzStakingGen
 			appGenStateJSON, err := json.Marshal(appState)
 			if err != nil {
 				return err
@@ -122,3 +121,26 @@ func GenTxClaimCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, ge
 
 	return cmd
 }
+
+// AddGenesisValidator appends a validator to the customstaking genesis of the
+// app state. The params and validators already in the genesis are kept, and
+// the default genesis is used when the app state has none.
+func AddGenesisValidator(cdc codec.JSONMarshaler, appState map[string]json.RawMessage, validator cumstomtypes.Validator) error {
+	stakingGenesisState := cumstomtypes.DefaultGenesis()
+	if bz, ok := appState[cumstomtypes.ModuleName]; ok {
+		if err := cdc.UnmarshalJSON(bz, stakingGenesisState); err != nil {
+			return fmt.Errorf("failed to unmarshal staking genesis state: %w", err)
+		}
+	}
+
+	stakingGenesisState.Validators = append(stakingGenesisState.Validators, validator)
+
+	bzStakingGen, err := cdc.MarshalJSON(stakingGenesisState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal staking genesis state: %w", err)
+	}
+
+	appState[cumstomtypes.ModuleName] = bzStakingGen
+
+	return nil
+}
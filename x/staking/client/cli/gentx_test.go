@@ -0,0 +1,85 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/staking"
+	"github.com/KiraCore/sekai/x/staking/client/cli"
+	customtypes "github.com/KiraCore/sekai/x/staking/types"
+)
+
+func TestAddGenesisValidator(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+
+	tests := []struct {
+		name           string
+		prepareState   func(appState simapp.GenesisState)
+		expectedParams customtypes.Params
+	}{
+		{
+			name:           "default genesis",
+			prepareState:   func(appState simapp.GenesisState) {},
+			expectedParams: customtypes.DefaultParams(),
+		},
+		{
+			name: "no customstaking genesis",
+			prepareState: func(appState simapp.GenesisState) {
+				delete(appState, customtypes.ModuleName)
+			},
+			expectedParams: customtypes.DefaultParams(),
+		},
+		{
+			name: "customized params",
+			prepareState: func(appState simapp.GenesisState) {
+				genState := customtypes.DefaultGenesis()
+				genState.Params.MaxValidators = 7
+				appState[customtypes.ModuleName] = cdc.MustMarshalJSON(genState)
+			},
+			expectedParams: func() customtypes.Params {
+				params := customtypes.DefaultParams()
+				params.MaxValidators = 7
+				return params
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			appState := simapp.NewDefaultGenesisState()
+			tt.prepareState(appState)
+
+			for _, moniker := range []string{"first", "second"} {
+				pubKey := ed25519.GenPrivKey().PubKey()
+				validator, err := customtypes.NewValidator(moniker, "website", "social", "identity", sdk.NewDec(1), sdk.ValAddress(pubKey.Address()), pubKey)
+				require.NoError(t, err)
+
+				require.NoError(t, cli.AddGenesisValidator(cdc, appState, validator))
+			}
+
+			var genState customtypes.GenesisState
+			cdc.MustUnmarshalJSON(appState[customtypes.ModuleName], &genState)
+			require.NoError(t, customtypes.ValidateGenesis(genState))
+			require.Equal(t, tt.expectedParams, genState.Params)
+			require.Len(t, genState.Validators, 2)
+
+			app := simapp.Setup(false)
+			ctx := app.NewContext(false, tmproto.Header{})
+
+			var valUpdates []abci.ValidatorUpdate
+			require.NotPanics(t, func() {
+				valUpdates = staking.InitGenesis(ctx, app.CustomStakingKeeper, genState)
+			})
+			require.Len(t, valUpdates, 2)
+			require.Equal(t, tt.expectedParams, app.CustomStakingKeeper.GetParams(ctx))
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/KiraCore/sekai/x/staking/types"
+)
+
+// GetCmdSubmitSetValidatorPowerProposal the submit set validator power proposal command.
+func GetCmdSubmitSetValidatorPowerProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-validator-power [val-addr] [power]",
+		Short: "Submit a proposal to set the voting power of a validator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return errors.Wrap(err, "invalid validator address")
+			}
+
+			power, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid power")
+			}
+
+			title, _ := cmd.Flags().GetString(govcli.FlagTitle)
+			description, _ := cmd.Flags().GetString(govcli.FlagDescription)
+
+			content := types.NewSetValidatorPowerProposal(title, description, valAddr, power)
+			return submitProposal(clientCtx, cmd, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitSetValidatorWhitelistProposal the submit set validator whitelist proposal command.
+func GetCmdSubmitSetValidatorWhitelistProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-validator-whitelist [val-addr] [true|false]",
+		Short: "Submit a proposal to allow or forbid an address to claim a validator seat",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return errors.Wrap(err, "invalid validator address")
+			}
+
+			whitelisted, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return errors.Wrap(err, "invalid whitelisted value")
+			}
+
+			title, _ := cmd.Flags().GetString(govcli.FlagTitle)
+			description, _ := cmd.Flags().GetString(govcli.FlagDescription)
+
+			content := types.NewSetValidatorWhitelistProposal(title, description, valAddr, whitelisted)
+			return submitProposal(clientCtx, cmd, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func submitProposal(clientCtx client.Context, cmd *cobra.Command, content govtypes.Content) error {
+	depositStr, _ := cmd.Flags().GetString(govcli.FlagDeposit)
+	deposit, err := sdk.ParseCoins(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "the proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "the proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "the proposal deposit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+}
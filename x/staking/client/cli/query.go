@@ -18,10 +18,32 @@ import (
 )
 
 const (
-	FlagValAddr = "val-addr"
-	FlagAddr    = "addr"
+	FlagValAddr       = "val-addr"
+	FlagAddr          = "addr"
+	FlagStatus        = "status"
+	FlagMonikerPrefix = "moniker-prefix"
 )
 
+// GetQueryCmd returns the parent command for all custom staking query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        cumstomtypes.ModuleName,
+		Short:                      "Custom staking query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryValidatorByAddress(),
+		GetCmdQueryInfractions(),
+		GetCmdQueryParams(),
+		GetCmdQueryValidators(),
+	)
+
+	return cmd
+}
+
 // GetCmdQueryValidatorByAddress the query delegation command.
 func GetCmdQueryValidatorByAddress() *cobra.Command {
 	cmd := &cobra.Command{
@@ -114,3 +136,108 @@ func validateQueryValidatorFlags(flagSet *pflag.FlagSet) error {
 
 	return nil
 }
+
+// GetCmdQueryInfractions the query infractions command.
+func GetCmdQueryInfractions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "infractions [val-addr]",
+		Short: "Query the double signs committed by a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return errors.Wrap(err, "invalid validator address")
+			}
+
+			params := &cumstomtypes.InfractionsRequest{ValAddr: valAddr}
+
+			queryClient := cumstomtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Infractions(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryParams the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the custom staking parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := cumstomtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &cumstomtypes.ParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidators the query all validators command.
+func GetCmdQueryValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators [--status] [--moniker-prefix]",
+		Short: "Query the validators, optionally filtered by status and moniker prefix",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			status, _ := cmd.Flags().GetString(FlagStatus)
+			monikerPrefix, _ := cmd.Flags().GetString(FlagMonikerPrefix)
+
+			params := &cumstomtypes.ValidatorsRequest{Status: status, MonikerPrefix: monikerPrefix, Pagination: pageReq}
+
+			queryClient := cumstomtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Validators(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validators")
+
+	cmd.Flags().String(FlagStatus, "", "the status of the validators (ACTIVE, INACTIVE, PAUSED, JAILED or EXITING)")
+	cmd.Flags().String(FlagMonikerPrefix, "", "the start of the moniker of the validators")
+
+	return cmd
+}
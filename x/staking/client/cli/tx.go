@@ -27,6 +27,29 @@ const (
 	FlagValKey    = "validator-key"
 )
 
+// GetTxCmd returns the parent command for all x/staking transaction commands.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        cumstomtypes.ModuleName,
+		Short:                      "Custom staking transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetTxClaimValidatorCmd(),
+		GetTxPauseCmd(),
+		GetTxUnpauseCmd(),
+		GetTxActivateCmd(),
+		GetTxInactivateCmd(),
+		GetTxEditValidatorCmd(),
+		GetTxExitValidatorCmd(),
+	)
+
+	return cmd
+}
+
 func GetTxClaimValidatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "claim-validator-seat",
@@ -82,3 +105,106 @@ func GetTxClaimValidatorCmd() *cobra.Command {
 
 	return cmd
 }
+
+func GetTxEditValidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-validator",
+		Short: "Edit the metadata and commission of the sender validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			moniker, _ := cmd.Flags().GetString(FlagMoniker)
+			website, _ := cmd.Flags().GetString(FlagWebsite)
+			social, _ := cmd.Flags().GetString(FlagSocial)
+			identity, _ := cmd.Flags().GetString(FlagIdentity)
+
+			var comm *types.Dec
+			if comission, _ := cmd.Flags().GetString(FlagComission); comission != "" {
+				dec, err := types.NewDecFromStr(comission)
+				if err != nil {
+					return errors.Wrap(err, "invalid commission")
+				}
+				comm = &dec
+			}
+
+			msg := cumstomtypes.NewMsgEditValidator(moniker, website, social, identity, comm, types.ValAddress(clientCtx.GetFromAddress()))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMoniker, cumstomtypes.DoNotModify, "the Moniker")
+	cmd.Flags().String(FlagWebsite, cumstomtypes.DoNotModify, "the Website")
+	cmd.Flags().String(FlagSocial, cumstomtypes.DoNotModify, "the social")
+	cmd.Flags().String(FlagIdentity, cumstomtypes.DoNotModify, "the Identity")
+	cmd.Flags().String(FlagComission, "", "the commission, left unchanged if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func GetTxPauseCmd() *cobra.Command {
+	return newValidatorStatusCmd("pause", "Pause the sender validator, taking it out of the validator set for maintenance", func(valKey types.ValAddress) types.Msg {
+		return cumstomtypes.NewMsgPause(valKey)
+	})
+}
+
+func GetTxUnpauseCmd() *cobra.Command {
+	return newValidatorStatusCmd("unpause", "Unpause the sender validator, bringing it back into the validator set", func(valKey types.ValAddress) types.Msg {
+		return cumstomtypes.NewMsgUnpause(valKey)
+	})
+}
+
+func GetTxActivateCmd() *cobra.Command {
+	return newValidatorStatusCmd("activate", "Activate the inactive sender validator, bringing it back into the validator set", func(valKey types.ValAddress) types.Msg {
+		return cumstomtypes.NewMsgActivate(valKey)
+	})
+}
+
+func GetTxInactivateCmd() *cobra.Command {
+	return newValidatorStatusCmd("inactivate", "Inactivate the sender validator, taking it out of the validator set until it is activated", func(valKey types.ValAddress) types.Msg {
+		return cumstomtypes.NewMsgInactivate(valKey)
+	})
+}
+
+func GetTxExitValidatorCmd() *cobra.Command {
+	return newValidatorStatusCmd("exit", "Exit the sender validator, removing it from the validators once the unbonding time is over", func(valKey types.ValAddress) types.Msg {
+		return cumstomtypes.NewMsgExitValidator(valKey)
+	})
+}
+
+func newValidatorStatusCmd(use string, short string, newMsg func(valKey types.ValAddress) types.Msg) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := newMsg(types.ValAddress(clientCtx.GetFromAddress()))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
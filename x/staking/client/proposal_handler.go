@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/KiraCore/sekai/x/staking/client/cli"
+	"github.com/KiraCore/sekai/x/staking/client/rest"
+)
+
+// Proposal handlers of the custom staking governance proposals.
+var (
+	SetValidatorPowerProposalHandler     = govclient.NewProposalHandler(cli.GetCmdSubmitSetValidatorPowerProposal, rest.SetValidatorPowerProposalRESTHandler)
+	SetValidatorWhitelistProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSetValidatorWhitelistProposal, rest.SetValidatorWhitelistProposalRESTHandler)
+)
@@ -0,0 +1,87 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/KiraCore/sekai/x/staking/types"
+)
+
+// SetValidatorPowerProposalReq defines a set validator power proposal request body.
+type SetValidatorPowerProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	ValKey      sdk.ValAddress `json:"val_key" yaml:"val_key"`
+	Power       int64          `json:"power" yaml:"power"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// SetValidatorPowerProposalRESTHandler returns the REST handler submitting set validator power proposals.
+func SetValidatorPowerProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_validator_power",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req SetValidatorPowerProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewSetValidatorPowerProposal(req.Title, req.Description, req.ValKey, req.Power)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// SetValidatorWhitelistProposalReq defines a set validator whitelist proposal request body.
+type SetValidatorWhitelistProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	ValKey      sdk.ValAddress `json:"val_key" yaml:"val_key"`
+	Whitelisted bool           `json:"whitelisted" yaml:"whitelisted"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// SetValidatorWhitelistProposalRESTHandler returns the REST handler submitting set validator whitelist proposals.
+func SetValidatorWhitelistProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_validator_whitelist",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req SetValidatorWhitelistProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewSetValidatorWhitelistProposal(req.Title, req.Description, req.ValKey, req.Whitelisted)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+func writeProposalTx(clientCtx client.Context, w http.ResponseWriter, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins, proposer sdk.AccAddress) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
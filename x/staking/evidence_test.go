@@ -0,0 +1,70 @@
+package staking_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/staking"
+	customtypes "github.com/KiraCore/sekai/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/types"
+	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+func TestHandleDoubleSign_TombstonesValidator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10, Time: time.Unix(1000, 0)})
+
+	evidenceKeeper := evidencekeeper.NewKeeper(
+		app.AppCodec(), app.GetKey(evidencetypes.StoreKey), app.CustomStakingKeeper, app.CustomStakingKeeper,
+	)
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	validator, err := customtypes.NewValidator("moniker", "", "", "", types.NewDecWithPrec(1, 1), types.ValAddress(pubKey.Address()), pubKey)
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, validator)
+	app.CustomStakingKeeper.ApplyValidatorSetUpdates(ctx)
+
+	evidenceKeeper.HandleDoubleSign(ctx, &evidencetypes.Equivocation{
+		Height:           8,
+		Time:             time.Unix(990, 0),
+		Power:            1,
+		ConsensusAddress: types.ConsAddress(pubKey.Address()),
+	})
+
+	// The validator leaves the set.
+	_, found := app.CustomStakingKeeper.GetValidatorByConsAddress(ctx, types.ConsAddress(pubKey.Address()))
+	require.False(t, found)
+	require.True(t, app.CustomStakingKeeper.IsTombstoned(ctx, types.ConsAddress(pubKey.Address())))
+
+	updates := app.CustomStakingKeeper.ApplyValidatorSetUpdates(ctx)
+	require.Len(t, updates, 1)
+	require.Equal(t, int64(0), updates[0].Power)
+
+	// The infraction is recorded.
+	querier := staking.NewQuerier(app.CustomStakingKeeper)
+	res, err := querier.Infractions(types.WrapSDKContext(ctx), &customtypes.InfractionsRequest{ValAddr: validator.ValKey})
+	require.NoError(t, err)
+	require.Equal(t, []customtypes.Infraction{
+		{
+			ValKey:  validator.ValKey,
+			Moniker: validator.Moniker,
+			PubKey:  validator.PubKey,
+			Height:  8,
+			Power:   1,
+		},
+	}, res.Infractions)
+
+	// The consensus pubkey can not claim a seat again.
+	app.CustomStakingKeeper.SetWhitelisted(ctx, validator.ValKey, true)
+	msg, err := customtypes.NewMsgClaimValidator("another", "", "", "", types.NewDecWithPrec(1, 1), validator.ValKey, pubKey)
+	require.NoError(t, err)
+
+	_, err = staking.NewHandler(app.CustomStakingKeeper)(ctx, msg)
+	require.EqualError(t, err, customtypes.ErrValidatorTombstoned.Error())
+}
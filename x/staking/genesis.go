@@ -0,0 +1,67 @@
+package staking
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/KiraCore/sekai/x/staking/keeper"
+	"github.com/KiraCore/sekai/x/staking/types"
+)
+
+// InitGenesis stores the validators of the genesis state, indexing them by
+// moniker and scheduling the removal of the exiting ones, along with the
+// whitelist, the tombstones and the infractions, and returns the ones with voting power as the initial validator set.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) []abci.ValidatorUpdate {
+	k.SetParams(ctx, data.Params)
+
+	for _, val := range data.Validators {
+		k.AddValidator(ctx, val)
+
+		if val.Status == types.Exiting {
+			k.InsertUnbondingQueue(ctx, val)
+		}
+	}
+
+	for _, valKey := range data.Whitelist {
+		k.SetWhitelisted(ctx, valKey, true)
+	}
+
+	for _, consAddr := range data.Tombstones {
+		k.Tombstone(ctx, consAddr)
+	}
+
+	for _, infraction := range data.Infractions {
+		k.SetInfraction(ctx, infraction)
+	}
+
+	return k.ApplyValidatorSetUpdates(ctx)
+}
+
+// ExportGenesis returns the params, every validator of the registry, the
+// claim whitelist, the tombstones and the infractions. The
+// validator indexes are rebuilt on import, and the downtime tracking starts
+// over.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	validators := k.GetValidatorSet(ctx)
+	if validators == nil {
+		validators = []types.Validator{}
+	}
+
+	whitelist := k.GetWhitelist(ctx)
+	if whitelist == nil {
+		whitelist = []sdk.ValAddress{}
+	}
+
+	tombstones := k.GetTombstones(ctx)
+	if tombstones == nil {
+		tombstones = []sdk.ConsAddress{}
+	}
+
+	infractions := k.GetAllInfractions(ctx)
+	if infractions == nil {
+		infractions = []types.Infraction{}
+	}
+
+	return types.NewGenesisState(k.GetParams(ctx), validators, whitelist, tombstones, infractions)
+}
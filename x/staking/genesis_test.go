@@ -0,0 +1,57 @@
+package staking_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/staking"
+	customtypes "github.com/KiraCore/sekai/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesis_ExportImportRoundTrip(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	require.Equal(t, customtypes.DefaultGenesis(), staking.ExportGenesis(ctx, app.CustomStakingKeeper))
+
+	valAddr, err := types.ValAddressFromBech32("kiravaloper15ky9du8a2wlstz6fpx3p4mqpjyrm5cgq38f2fp")
+	require.NoError(t, err)
+	pubKey, err := types.GetPubKeyFromBech32(types.Bech32PubKeyTypeConsPub, "kiravalconspub1zcjduepqylc5k8r40azmw0xt7hjugr4mr5w2am7jw77ux5w6s8hpjxyrjjsq4xg7em")
+	require.NoError(t, err)
+
+	validator, err := customtypes.NewValidator("aMoniker", "some-web.com", "A Social", "My Identity", types.NewDecWithPrec(1, 1), valAddr, pubKey)
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, validator)
+	app.CustomStakingKeeper.SetWhitelisted(ctx, valAddr, true)
+
+	// A validator removed for double signing.
+	tombstonedPubKey := ed25519.GenPrivKey().PubKey()
+	tombstoned, err := customtypes.NewValidator("tombstoned", "some-web.com", "A Social", "My Identity", types.NewDecWithPrec(1, 1), types.ValAddress(tombstonedPubKey.Address()), tombstonedPubKey)
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, tombstoned)
+	infraction := customtypes.Infraction{ValKey: tombstoned.ValKey, Moniker: tombstoned.Moniker, PubKey: tombstoned.PubKey, Height: 5, Power: 1}
+	app.CustomStakingKeeper.SetInfraction(ctx, infraction)
+	app.CustomStakingKeeper.Tombstone(ctx, tombstoned.GetConsAddr())
+
+	exported := staking.ExportGenesis(ctx, app.CustomStakingKeeper)
+	require.Equal(t, []customtypes.Validator{validator}, exported.Validators)
+	require.Equal(t, []types.ValAddress{valAddr}, exported.Whitelist)
+	require.Equal(t, []types.ConsAddress{tombstoned.GetConsAddr()}, exported.Tombstones)
+	require.Equal(t, []customtypes.Infraction{infraction}, exported.Infractions)
+	require.NoError(t, customtypes.ValidateGenesis(*exported))
+
+	app2 := simapp.Setup(false)
+	ctx2 := app2.NewContext(false, tmproto.Header{})
+	valUpdates := staking.InitGenesis(ctx2, app2.CustomStakingKeeper, *exported)
+	require.Len(t, valUpdates, 1)
+
+	require.Equal(t, exported, staking.ExportGenesis(ctx2, app2.CustomStakingKeeper))
+	require.Equal(t, validator, app2.CustomStakingKeeper.GetValidatorByMoniker(ctx2, validator.Moniker))
+	require.True(t, app2.CustomStakingKeeper.IsTombstoned(ctx2, tombstoned.GetConsAddr()))
+	require.Equal(t, []customtypes.Infraction{infraction}, app2.CustomStakingKeeper.GetInfractions(ctx2, tombstoned.ValKey))
+}
@@ -15,6 +15,18 @@ func NewHandler(ck customkeeper.Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case *types.MsgClaimValidator:
 			return handleMsgClaimValidator(ctx, ck, msg)
+		case *types.MsgPause:
+			return handleMsgPause(ctx, ck, msg)
+		case *types.MsgUnpause:
+			return handleMsgUnpause(ctx, ck, msg)
+		case *types.MsgActivate:
+			return handleMsgActivate(ctx, ck, msg)
+		case *types.MsgInactivate:
+			return handleMsgInactivate(ctx, ck, msg)
+		case *types.MsgEditValidator:
+			return handleMsgEditValidator(ctx, ck, msg)
+		case *types.MsgExitValidator:
+			return handleMsgExitValidator(ctx, ck, msg)
 		default:
 			return nil, errors.Wrapf(errors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -22,17 +34,110 @@ func NewHandler(ck customkeeper.Keeper) sdk.Handler {
 }
 
 func handleMsgClaimValidator(ctx sdk.Context, k customkeeper.Keeper, msg *types.MsgClaimValidator) (*sdk.Result, error) {
+	params := k.GetParams(ctx)
+
+	// Claims delivered at genesis come from gentxs, admitted by whoever
+	// assembles the genesis file.
+	if ctx.BlockHeight() > 0 && params.WhitelistEnabled && !k.IsWhitelisted(ctx, msg.ValKey) {
+		return nil, types.ErrValidatorNotWhitelisted
+	}
+
 	valPubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, msg.PubKey)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get consensus node public key")
 	}
 
+	consAddr := sdk.ConsAddress(valPubKey.Address())
+	if k.IsTombstoned(ctx, consAddr) {
+		return nil, types.ErrValidatorTombstoned
+	}
+
+	if k.HasValidator(ctx, msg.ValKey) {
+		if val := k.GetValidator(ctx, msg.ValKey); val.Status == types.Exiting {
+			return nil, errors.Wrapf(types.ErrValidatorExiting, "claim allowed once unbonded at %d", val.UnbondingUntil)
+		}
+
+		return nil, errors.Wrap(types.ErrValidatorKeyExists, msg.ValKey.String())
+	}
+
+	if k.HasValidatorMoniker(ctx, msg.Moniker) {
+		return nil, errors.Wrap(types.ErrValidatorMonikerExists, msg.Moniker)
+	}
+
+	if k.HasValidatorConsAddress(ctx, consAddr) {
+		return nil, errors.Wrap(types.ErrValidatorPubKeyExists, msg.PubKey)
+	}
+
 	validator, err := types.NewValidator(msg.Moniker, msg.Website, msg.Social, msg.Identity, msg.Commission, msg.ValKey, valPubKey)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := params.ValidateValidator(validator); err != nil {
+		return nil, err
+	}
+
+	validator.Power = params.DefaultValidatorPower
+	if err := k.ValidateValidatorPower(ctx, validator.ValKey, validator.Power); err != nil {
+		return nil, err
+	}
+
+	validator.CommissionUpdatedAt = ctx.BlockTime().Unix()
 	k.AddValidator(ctx, validator)
 
 	return &sdk.Result{}, nil
 }
+
+func handleMsgPause(ctx sdk.Context, k customkeeper.Keeper, msg *types.MsgPause) (*sdk.Result, error) {
+	_, err := k.Pause(ctx, msg.ValKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{}, nil
+}
+
+func handleMsgUnpause(ctx sdk.Context, k customkeeper.Keeper, msg *types.MsgUnpause) (*sdk.Result, error) {
+	_, err := k.Unpause(ctx, msg.ValKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{}, nil
+}
+
+func handleMsgActivate(ctx sdk.Context, k customkeeper.Keeper, msg *types.MsgActivate) (*sdk.Result, error) {
+	_, err := k.Activate(ctx, msg.ValKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{}, nil
+}
+
+func handleMsgInactivate(ctx sdk.Context, k customkeeper.Keeper, msg *types.MsgInactivate) (*sdk.Result, error) {
+	_, err := k.Inactivate(ctx, msg.ValKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{}, nil
+}
+
+func handleMsgEditValidator(ctx sdk.Context, k customkeeper.Keeper, msg *types.MsgEditValidator) (*sdk.Result, error) {
+	_, err := k.EditValidator(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{}, nil
+}
+
+func handleMsgExitValidator(ctx sdk.Context, k customkeeper.Keeper, msg *types.MsgExitValidator) (*sdk.Result, error) {
+	_, err := k.ExitValidator(ctx, msg.ValKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{}, nil
+}
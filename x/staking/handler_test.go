@@ -1,7 +1,10 @@
 package staking_test
 
 import (
+	"errors"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/KiraCore/sekai/app"
 
@@ -11,11 +14,14 @@ import (
 	types2 "github.com/KiraCore/sekai/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 func TestMain(m *testing.M) {
 	app.SetConfig()
+	os.Exit(m.Run())
 }
 
 func TestNewHandler_MsgClaimValidator_HappyPath(t *testing.T) {
@@ -35,7 +41,7 @@ func TestNewHandler_MsgClaimValidator_HappyPath(t *testing.T) {
 		"some-web.com",
 		"A Social",
 		"My Identity",
-		types.NewDec(1234),
+		types.NewDecWithPrec(1, 1),
 		valAddr1,
 		pubKey,
 	)
@@ -51,6 +57,136 @@ func TestNewHandler_MsgClaimValidator_HappyPath(t *testing.T) {
 	validatorIsEqualThanClaimMsg(t, val, theMsg)
 }
 
+func TestNewHandler_MsgClaimValidator_Whitelist(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+
+	handler := staking.NewHandler(app.CustomStakingKeeper)
+	newMsg := func(moniker string) *types2.MsgClaimValidator {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		msg, err := types2.NewMsgClaimValidator(moniker, "some-web.com", "A Social", "My Identity", types.NewDecWithPrec(1, 1), types.ValAddress(pubKey.Address()), pubKey)
+		require.NoError(t, err)
+		return msg
+	}
+
+	// The whitelist is off on a default chain, so seats can be claimed after genesis.
+	require.False(t, app.CustomStakingKeeper.GetParams(ctx).WhitelistEnabled)
+	_, err := handler(ctx, newMsg("open"))
+	require.NoError(t, err)
+
+	params := app.CustomStakingKeeper.GetParams(ctx)
+	params.WhitelistEnabled = true
+	app.CustomStakingKeeper.SetParams(ctx, params)
+
+	rejected := newMsg("rejected")
+	_, err = handler(ctx, rejected)
+	require.True(t, errors.Is(err, types2.ErrValidatorNotWhitelisted))
+	require.False(t, app.CustomStakingKeeper.HasValidator(ctx, rejected.ValKey))
+
+	accepted := newMsg("accepted")
+	app.CustomStakingKeeper.SetWhitelisted(ctx, accepted.ValKey, true)
+	_, err = handler(ctx, accepted)
+	require.NoError(t, err)
+	require.True(t, app.CustomStakingKeeper.HasValidator(ctx, accepted.ValKey))
+}
+
+func TestNewHandler_MsgClaimValidator_RejectsDuplicates(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	handler := staking.NewHandler(app.CustomStakingKeeper)
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	valAddr := types.ValAddress(pubKey.Address())
+	_, err := handler(ctx, mustNewMsgClaimValidator(t, "aMoniker", valAddr, pubKey))
+	require.NoError(t, err)
+
+	otherPubKey := ed25519.GenPrivKey().PubKey()
+	otherValAddr := types.ValAddress(otherPubKey.Address())
+
+	tests := []struct {
+		name        string
+		msg         *types2.MsgClaimValidator
+		expectedErr error
+	}{
+		{"duplicate ValKey", mustNewMsgClaimValidator(t, "another", valAddr, otherPubKey), types2.ErrValidatorKeyExists},
+		{"duplicate moniker", mustNewMsgClaimValidator(t, "aMoniker", otherValAddr, otherPubKey), types2.ErrValidatorMonikerExists},
+		{"duplicate consensus pubkey", mustNewMsgClaimValidator(t, "another", otherValAddr, pubKey), types2.ErrValidatorPubKeyExists},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler(ctx, tt.msg)
+			require.True(t, errors.Is(err, tt.expectedErr), err)
+		})
+	}
+
+	// The claimed validator and its indexes are untouched.
+	require.Len(t, app.CustomStakingKeeper.GetValidatorSet(ctx), 1)
+	val := app.CustomStakingKeeper.GetValidatorByMoniker(ctx, "aMoniker")
+	require.Equal(t, valAddr, val.ValKey)
+	validatorIsEqualThanClaimMsg(t, val, mustNewMsgClaimValidator(t, "aMoniker", valAddr, pubKey))
+}
+
+func TestNewHandler_ValidatorStatusTransitions(t *testing.T) {
+	valAddr1, err := types.ValAddressFromBech32("kiravaloper15ky9du8a2wlstz6fpx3p4mqpjyrm5cgq38f2fp")
+	require.NoError(t, err)
+
+	pubKey, err := types.GetPubKeyFromBech32(types.Bech32PubKeyTypeConsPub, "kiravalconspub1zcjduepqylc5k8r40azmw0xt7hjugr4mr5w2am7jw77ux5w6s8hpjxyrjjsq4xg7em")
+	require.NoError(t, err)
+
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	handler := staking.NewHandler(app.CustomStakingKeeper)
+
+	_, err = handler(ctx, types2.NewMsgPause(valAddr1))
+	require.Equal(t, types2.ErrValidatorNotFound, err)
+
+	theMsg, err := types2.NewMsgClaimValidator("aMoniker", "some-web.com", "A Social", "My Identity", types.NewDecWithPrec(1, 1), valAddr1, pubKey)
+	require.NoError(t, err)
+	_, err = handler(ctx, theMsg)
+	require.NoError(t, err)
+	require.Len(t, app.CustomStakingKeeper.ApplyValidatorSetUpdates(ctx), 1)
+
+	tests := []struct {
+		name        string
+		msg         types.Msg
+		expectError bool
+		status      types2.ValidatorStatus
+	}{
+		{"unpause active", types2.NewMsgUnpause(valAddr1), true, types2.Active},
+		{"activate active", types2.NewMsgActivate(valAddr1), true, types2.Active},
+		{"pause active", types2.NewMsgPause(valAddr1), false, types2.Paused},
+		{"pause paused", types2.NewMsgPause(valAddr1), true, types2.Paused},
+		{"activate paused", types2.NewMsgActivate(valAddr1), true, types2.Paused},
+		{"inactivate paused", types2.NewMsgInactivate(valAddr1), true, types2.Paused},
+		{"unpause paused", types2.NewMsgUnpause(valAddr1), false, types2.Active},
+		{"inactivate active", types2.NewMsgInactivate(valAddr1), false, types2.Inactive},
+		{"unpause inactive", types2.NewMsgUnpause(valAddr1), true, types2.Inactive},
+		{"activate inactive", types2.NewMsgActivate(valAddr1), false, types2.Active},
+	}
+	for _, tt := range tests {
+		_, err := handler(ctx, tt.msg)
+		if tt.expectError {
+			require.True(t, errors.Is(err, types2.ErrInvalidStatusTransition), tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+		}
+
+		val := app.CustomStakingKeeper.GetValidator(ctx, valAddr1)
+		require.Equal(t, tt.status, val.Status, tt.name)
+
+		// Only active validators are in the Tendermint validator set.
+		expectedPower := int64(0)
+		if tt.status == types2.Active {
+			expectedPower = val.Power
+		}
+		app.CustomStakingKeeper.ApplyValidatorSetUpdates(ctx)
+		require.Equal(t, expectedPower, app.CustomStakingKeeper.GetLastValidatorPower(ctx, valAddr1), tt.name)
+	}
+}
+
 func validatorIsEqualThanClaimMsg(t *testing.T, val types2.Validator, msg *types2.MsgClaimValidator) {
 	require.Equal(t, msg.Moniker, val.Moniker)
 	require.Equal(t, msg.PubKey, val.PubKey)
@@ -60,3 +196,112 @@ func validatorIsEqualThanClaimMsg(t *testing.T, val types2.Validator, msg *types
 	require.Equal(t, msg.Social, val.Social)
 	require.Equal(t, msg.Website, val.Website)
 }
+
+func TestNewHandler_MsgEditValidator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	params := app.CustomStakingKeeper.GetParams(ctx)
+	params.MaxCommissionChangeRate = types.NewDecWithPrec(1, 2)
+	params.CommissionChangePeriod = 100
+	app.CustomStakingKeeper.SetParams(ctx, params)
+
+	handler := staking.NewHandler(app.CustomStakingKeeper)
+
+	pubKey1, pubKey2 := ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()
+	valAddr1, valAddr2 := types.ValAddress(pubKey1.Address()), types.ValAddress(pubKey2.Address())
+	for _, msg := range []*types2.MsgClaimValidator{
+		mustNewMsgClaimValidator(t, "validator1", valAddr1, pubKey1),
+		mustNewMsgClaimValidator(t, "validator2", valAddr2, pubKey2),
+	} {
+		_, err := handler(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	_, err := handler(ctx, types2.NewMsgEditValidator("renamed", "new-web.com", types2.DoNotModify, types2.DoNotModify, nil, valAddr1))
+	require.NoError(t, err)
+
+	val := app.CustomStakingKeeper.GetValidator(ctx, valAddr1)
+	require.Equal(t, "renamed", val.Moniker)
+	require.Equal(t, "new-web.com", val.Website)
+	require.Equal(t, "A Social", val.Social)
+	require.Equal(t, "My Identity", val.Identity)
+
+	// The moniker index follows the rename.
+	require.Equal(t, valAddr1, app.CustomStakingKeeper.GetValidatorByMoniker(ctx, "renamed").ValKey)
+	require.False(t, ctx.KVStore(app.GetKey(types2.ModuleName)).Has(types2.GetValidatorByMonikerKey("validator1")))
+
+	_, err = handler(ctx, types2.NewMsgEditValidator("validator2", types2.DoNotModify, types2.DoNotModify, types2.DoNotModify, nil, valAddr1))
+	require.True(t, errors.Is(err, types2.ErrValidatorMonikerExists))
+
+	_, err = handler(ctx, types2.NewMsgEditValidator(types2.DoNotModify, types2.DoNotModify, types2.DoNotModify, types2.DoNotModify, nil, types.ValAddress("unknown")))
+	require.Equal(t, types2.ErrValidatorNotFound, err)
+
+	// Commission changes are rate limited.
+	commission := types.NewDecWithPrec(11, 2)
+	_, err = handler(ctx, types2.NewMsgEditValidator(types2.DoNotModify, types2.DoNotModify, types2.DoNotModify, types2.DoNotModify, &commission, valAddr1))
+	require.True(t, errors.Is(err, types2.ErrCommissionChangeTooSoon))
+
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	tooLarge := types.NewDecWithPrec(12, 2)
+	_, err = handler(ctx, types2.NewMsgEditValidator(types2.DoNotModify, types2.DoNotModify, types2.DoNotModify, types2.DoNotModify, &tooLarge, valAddr1))
+	require.True(t, errors.Is(err, types2.ErrCommissionChangeTooLarge))
+
+	_, err = handler(ctx, types2.NewMsgEditValidator(types2.DoNotModify, types2.DoNotModify, types2.DoNotModify, types2.DoNotModify, &commission, valAddr1))
+	require.NoError(t, err)
+
+	val = app.CustomStakingKeeper.GetValidator(ctx, valAddr1)
+	require.Equal(t, commission, val.Commission)
+	require.Equal(t, int64(1100), val.CommissionUpdatedAt)
+}
+
+func TestNewHandler_MsgEditValidator_TightenedParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	handler := staking.NewHandler(app.CustomStakingKeeper)
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	valAddr := types.ValAddress(pubKey.Address())
+	_, err := handler(ctx, mustNewMsgClaimValidator(t, "validator1", valAddr, pubKey))
+	require.NoError(t, err)
+
+	// Governance tightens the params below what the validator has.
+	params := app.CustomStakingKeeper.GetParams(ctx)
+	params.MaxFieldLength = 10
+	params.MaxCommission = types.NewDecWithPrec(5, 2)
+	params.CommissionChangePeriod = 0
+	app.CustomStakingKeeper.SetParams(ctx, params)
+
+	// Fields left as they are are not checked.
+	_, err = handler(ctx, types2.NewMsgEditValidator(types2.DoNotModify, types2.DoNotModify, "social", types2.DoNotModify, nil, valAddr))
+	require.NoError(t, err)
+
+	// The offending fields can be fixed, and new ones must fit.
+	_, err = handler(ctx, types2.NewMsgEditValidator(types2.DoNotModify, "web.com", types2.DoNotModify, "identity", nil, valAddr))
+	require.NoError(t, err)
+
+	_, err = handler(ctx, types2.NewMsgEditValidator("a-too-long-moniker", types2.DoNotModify, types2.DoNotModify, types2.DoNotModify, nil, valAddr))
+	require.True(t, errors.Is(err, types2.ErrFieldTooLong))
+
+	// The commission can move towards the bounds within the change rate.
+	towards := types.NewDecWithPrec(9, 2)
+	_, err = handler(ctx, types2.NewMsgEditValidator(types2.DoNotModify, types2.DoNotModify, types2.DoNotModify, types2.DoNotModify, &towards, valAddr))
+	require.NoError(t, err)
+
+	away := types.NewDecWithPrec(10, 2)
+	_, err = handler(ctx, types2.NewMsgEditValidator(types2.DoNotModify, types2.DoNotModify, types2.DoNotModify, types2.DoNotModify, &away, valAddr))
+	require.True(t, errors.Is(err, types2.ErrInvalidCommission))
+
+	val := app.CustomStakingKeeper.GetValidator(ctx, valAddr)
+	require.Equal(t, "web.com", val.Website)
+	require.Equal(t, "social", val.Social)
+	require.Equal(t, "identity", val.Identity)
+	require.Equal(t, towards, val.Commission)
+}
+
+func mustNewMsgClaimValidator(t *testing.T, moniker string, valKey types.ValAddress, pubKey crypto.PubKey) *types2.MsgClaimValidator {
+	msg, err := types2.NewMsgClaimValidator(moniker, "some-web.com", "A Social", "My Identity", types.NewDecWithPrec(1, 1), valKey, pubKey)
+	require.NoError(t, err)
+	return msg
+}
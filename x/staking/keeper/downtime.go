@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HandleValidatorSignature records whether an active validator signed the
+// last block in its missed blocks bitmap, and jails it once it missed more
+// blocks of the signed blocks window than the params allow. Validators are
+// only judged after their first full window.
+func (k Keeper) HandleValidatorSignature(ctx sdk.Context, consAddr sdk.ConsAddress, signed bool) {
+	validator, found := k.GetValidatorByConsAddress(ctx, consAddr)
+	if !found || validator.Status != types.Active {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight()
+
+	info, found := k.GetValidatorSigningInfo(ctx, validator.ValKey)
+	if !found {
+		info = types.ValidatorSigningInfo{StartHeight: height}
+	}
+
+	index := info.IndexOffset % params.SignedBlocksWindow
+	info.IndexOffset++
+
+	previous := k.getValidatorMissedBlock(ctx, validator.ValKey, index)
+	missed := !signed
+	switch {
+	case !previous && missed:
+		k.setValidatorMissedBlock(ctx, validator.ValKey, index, true)
+		info.MissedBlocksCounter++
+	case previous && !missed:
+		k.setValidatorMissedBlock(ctx, validator.ValKey, index, false)
+		info.MissedBlocksCounter--
+	}
+
+	if height >= info.StartHeight+params.SignedBlocksWindow && info.MissedBlocksCounter > params.MaxMissedBlocksPerWindow() {
+		jailedUntil := ctx.BlockTime().Unix() + params.DowntimeJailDuration
+		k.jailValidator(ctx, validator, jailedUntil)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeJailValidator,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.ValKey.String()),
+				sdk.NewAttribute(types.AttributeKeyMissedBlocks, fmt.Sprintf("%d", info.MissedBlocksCounter)),
+				sdk.NewAttribute(types.AttributeKeyJailedUntil, fmt.Sprintf("%d", jailedUntil)),
+			),
+		)
+		return
+	}
+
+	k.SetValidatorSigningInfo(ctx, validator.ValKey, info)
+}
+
+// jailValidator takes a validator out of the validator set until jailedUntil
+// and forgets its downtime, so it starts a new window once activated again.
+func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator, jailedUntil int64) {
+	validator.Status = types.Jailed
+	validator.JailedUntil = jailedUntil
+	k.AddValidator(ctx, validator)
+
+	k.deleteValidatorSigningInfo(ctx, validator.ValKey)
+}
+
+func (k Keeper) GetValidatorSigningInfo(ctx sdk.Context, address sdk.ValAddress) (types.ValidatorSigningInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorSigningInfoKey(address))
+	if bz == nil {
+		return types.ValidatorSigningInfo{}, false
+	}
+
+	var info types.ValidatorSigningInfo
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+
+	return info, true
+}
+
+func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, address sdk.ValAddress, info types.ValidatorSigningInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&info)
+	store.Set(types.GetValidatorSigningInfoKey(address), bz)
+}
+
+// deleteValidatorSigningInfo removes the signing info of a validator and its
+// missed blocks bitmap.
+func (k Keeper) deleteValidatorSigningInfo(ctx sdk.Context, address sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorSigningInfoKey(address))
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorMissedBlockBitArrayPrefix(address))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) getValidatorMissedBlock(ctx sdk.Context, address sdk.ValAddress, index int64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorMissedBlockBitArrayKey(address, index))
+}
+
+// setValidatorMissedBlock sets a position of the missed blocks bitmap. Only
+// missed blocks are stored.
+func (k Keeper) setValidatorMissedBlock(ctx sdk.Context, address sdk.ValAddress, index int64, missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !missed {
+		store.Delete(types.GetValidatorMissedBlockBitArrayKey(address, index))
+		return
+	}
+
+	store.Set(types.GetValidatorMissedBlockBitArrayKey(address, index), []byte{1})
+}
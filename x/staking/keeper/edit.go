@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EditValidator updates the metadata and commission of a validator. Fields set
+// to types.DoNotModify, and a nil commission, are left unchanged. A new
+// moniker must be free, the changed fields must fit the params, and the
+// commission can only change by the max commission change rate once per
+// commission change period. Only the changed fields are checked against the
+// params, so a validator left out of tightened params can still fix them; a
+// commission out of the params bounds may move towards them.
+func (k Keeper) EditValidator(ctx sdk.Context, msg *types.MsgEditValidator) (types.Validator, error) {
+	validator, err := k.getExistingValidator(ctx, msg.ValKey)
+	if err != nil {
+		return types.Validator{}, err
+	}
+
+	params := k.GetParams(ctx)
+
+	oldMoniker := validator.Moniker
+	if msg.Moniker != types.DoNotModify && msg.Moniker != oldMoniker {
+		if err := params.ValidateField("moniker", msg.Moniker); err != nil {
+			return types.Validator{}, err
+		}
+
+		if k.HasValidatorMoniker(ctx, msg.Moniker) {
+			return types.Validator{}, sdkerrors.Wrap(types.ErrValidatorMonikerExists, msg.Moniker)
+		}
+
+		validator.Moniker = msg.Moniker
+	}
+
+	for _, field := range []struct {
+		name, value string
+		target      *string
+	}{
+		{"website", msg.Website, &validator.Website},
+		{"social", msg.Social, &validator.Social},
+		{"identity", msg.Identity, &validator.Identity},
+	} {
+		if field.value == types.DoNotModify || field.value == *field.target {
+			continue
+		}
+
+		if err := params.ValidateField(field.name, field.value); err != nil {
+			return types.Validator{}, err
+		}
+
+		*field.target = field.value
+	}
+
+	if msg.Commission != nil && !msg.Commission.Equal(validator.Commission) {
+		if err := params.ValidateCommission(*msg.Commission); err != nil && !commissionTowardsBounds(params, validator.Commission, *msg.Commission) {
+			return types.Validator{}, err
+		}
+
+		now := ctx.BlockTime().Unix()
+
+		if now-validator.CommissionUpdatedAt < params.CommissionChangePeriod {
+			return types.Validator{}, sdkerrors.Wrap(types.ErrCommissionChangeTooSoon, fmt.Sprintf("next change allowed at %d", validator.CommissionUpdatedAt+params.CommissionChangePeriod))
+		}
+
+		if msg.Commission.Sub(validator.Commission).Abs().GT(params.MaxCommissionChangeRate) {
+			return types.Validator{}, sdkerrors.Wrap(types.ErrCommissionChangeTooLarge, fmt.Sprintf("max change %s", params.MaxCommissionChangeRate))
+		}
+
+		validator.Commission = *msg.Commission
+		validator.CommissionUpdatedAt = now
+	}
+
+	if err := validator.Validate(); err != nil {
+		return types.Validator{}, err
+	}
+
+	if validator.Moniker != oldMoniker {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetValidatorByMonikerKey(oldMoniker))
+	}
+	k.AddValidator(ctx, validator)
+
+	return validator, nil
+}
+
+// commissionTowardsBounds reports whether a new commission out of the params
+// bounds is closer to them than the old one.
+func commissionTowardsBounds(params types.Params, old, new sdk.Dec) bool {
+	switch {
+	case new.GT(params.MaxCommission):
+		return new.LT(old)
+	case new.LT(params.MinCommission):
+		return new.GT(old)
+	default:
+		return true
+	}
+}
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// The keeper stands in for both the staking and the slashing keeper of the
+// evidence module, so double signs punish custom staking validators. Custom
+// staking validators have no tokens at stake, so a double sign tombstones
+// the validator instead of slashing it.
+var (
+	_ evidencetypes.StakingKeeper  = Keeper{}
+	_ evidencetypes.SlashingKeeper = Keeper{}
+)
+
+// ValidatorByConsAddr returns the validator signing with a consensus address,
+// nil if there is none.
+func (k Keeper) ValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) stakingexported.ValidatorI {
+	validator, found := k.GetValidatorByConsAddress(ctx, consAddr)
+	if !found {
+		return nil
+	}
+
+	return evidenceValidator{validator}
+}
+
+// GetPubkey returns the consensus pubkey of the validator signing with a
+// consensus address.
+func (k Keeper) GetPubkey(ctx sdk.Context, address crypto.Address) (crypto.PubKey, error) {
+	validator, found := k.GetValidatorByConsAddress(ctx, sdk.ConsAddress(address))
+	if !found {
+		return nil, fmt.Errorf("validator with consensus-address %s not found", sdk.ConsAddress(address))
+	}
+
+	return validator.GetConsPubKey(), nil
+}
+
+// HasValidatorSigningInfo reports whether a validator signs with a consensus
+// address. Downtime is only tracked for active validators, but any validator
+// can be punished for a double sign.
+func (k Keeper) HasValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	_, found := k.GetValidatorByConsAddress(ctx, consAddr)
+	return found
+}
+
+// IsTombstoned reports whether a consensus address was tombstoned for a double sign.
+func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetTombstoneKey(consAddr))
+}
+
+// Slash records a double sign in the infractions of the validator. The
+// evidence module passes the height of the double sign less the validator
+// update delay.
+func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, _ sdk.Dec, power int64, distributionHeight int64) {
+	validator, found := k.GetValidatorByConsAddress(ctx, consAddr)
+	if !found {
+		return
+	}
+
+	k.SetInfraction(ctx, types.Infraction{
+		ValKey:  validator.ValKey,
+		Moniker: validator.Moniker,
+		PubKey:  validator.PubKey,
+		Height:  distributionHeight + sdk.ValidatorUpdateDelay,
+		Power:   power,
+	})
+}
+
+// SlashFractionDoubleSign returns zero, there are no tokens to slash.
+func (k Keeper) SlashFractionDoubleSign(sdk.Context) sdk.Dec {
+	return sdk.ZeroDec()
+}
+
+// Jail takes the validator signing with a consensus address out of the validator set.
+func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	validator, found := k.GetValidatorByConsAddress(ctx, consAddr)
+	if !found {
+		return
+	}
+
+	validator.Status = types.Jailed
+	k.AddValidator(ctx, validator)
+}
+
+// JailUntil sets the time the validator signing with a consensus address can
+// be activated again at.
+func (k Keeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time) {
+	validator, found := k.GetValidatorByConsAddress(ctx, consAddr)
+	if !found {
+		return
+	}
+
+	validator.JailedUntil = jailTime.Unix()
+	k.AddValidator(ctx, validator)
+}
+
+// Tombstone removes the validator signing with a consensus address for good.
+// Its consensus pubkey can not claim a validator seat anymore, and it leaves
+// the Tendermint set with the next validator set updates.
+func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTombstoneKey(consAddr), []byte{1})
+
+	validator, found := k.GetValidatorByConsAddress(ctx, consAddr)
+	if !found {
+		return
+	}
+
+	k.deleteValidatorSigningInfo(ctx, validator.ValKey)
+	k.RemoveValidator(ctx, validator)
+}
+
+// GetTombstones returns every tombstoned consensus address.
+func (k Keeper) GetTombstones(ctx sdk.Context) []sdk.ConsAddress {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.TombstonesKey)
+	defer iter.Close()
+
+	var tombstones []sdk.ConsAddress
+	for ; iter.Valid(); iter.Next() {
+		tombstones = append(tombstones, sdk.ConsAddress(iter.Key()[len(types.TombstonesKey):]))
+	}
+
+	return tombstones
+}
+
+func (k Keeper) SetInfraction(ctx sdk.Context, infraction types.Infraction) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&infraction)
+	store.Set(types.GetInfractionKey(infraction.ValKey, infraction.Height), bz)
+}
+
+// GetInfractions returns the double signs committed by a validator, oldest first.
+func (k Keeper) GetInfractions(ctx sdk.Context, address sdk.ValAddress) []types.Infraction {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetInfractionsPrefix(address))
+	defer iter.Close()
+
+	var infractions []types.Infraction
+	for ; iter.Valid(); iter.Next() {
+		var infraction types.Infraction
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &infraction)
+		infractions = append(infractions, infraction)
+	}
+
+	return infractions
+}
+
+// GetAllInfractions returns the double signs committed by every validator.
+func (k Keeper) GetAllInfractions(ctx sdk.Context) []types.Infraction {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.InfractionsKey)
+	defer iter.Close()
+
+	var infractions []types.Infraction
+	for ; iter.Valid(); iter.Next() {
+		var infraction types.Infraction
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &infraction)
+		infractions = append(infractions, infraction)
+	}
+
+	return infractions
+}
+
+// evidenceValidator exposes a validator to the evidence module. Custom
+// staking validators are never unbonded and have no tokens or delegations,
+// their tokens being their consensus power.
+type evidenceValidator struct {
+	validator types.Validator
+}
+
+var _ stakingexported.ValidatorI = evidenceValidator{}
+
+func (v evidenceValidator) IsJailed() bool                { return v.validator.Status == types.Jailed }
+func (v evidenceValidator) GetMoniker() string            { return v.validator.Moniker }
+func (v evidenceValidator) GetOperator() sdk.ValAddress   { return v.validator.ValKey }
+func (v evidenceValidator) GetConsPubKey() crypto.PubKey  { return v.validator.GetConsPubKey() }
+func (v evidenceValidator) GetConsAddr() sdk.ConsAddress  { return v.validator.GetConsAddr() }
+func (v evidenceValidator) GetConsensusPower() int64      { return v.validator.ConsensusPower() }
+func (v evidenceValidator) GetCommission() sdk.Dec        { return v.validator.Commission }
+func (v evidenceValidator) GetMinSelfDelegation() sdk.Int { return sdk.ZeroInt() }
+func (v evidenceValidator) GetDelegatorShares() sdk.Dec   { return sdk.ZeroDec() }
+func (v evidenceValidator) IsUnbonded() bool              { return false }
+func (v evidenceValidator) IsUnbonding() bool             { return false }
+
+func (v evidenceValidator) IsBonded() bool {
+	return v.validator.Status == types.Active
+}
+
+func (v evidenceValidator) GetStatus() sdk.BondStatus {
+	if v.IsBonded() {
+		return sdk.Bonded
+	}
+
+	return sdk.Unbonding
+}
+
+func (v evidenceValidator) GetTokens() sdk.Int {
+	return sdk.TokensFromConsensusPower(v.validator.ConsensusPower())
+}
+
+func (v evidenceValidator) GetBondedTokens() sdk.Int {
+	return v.GetTokens()
+}
+
+func (v evidenceValidator) TokensFromShares(sdk.Dec) sdk.Dec          { return sdk.ZeroDec() }
+func (v evidenceValidator) TokensFromSharesTruncated(sdk.Dec) sdk.Dec { return sdk.ZeroDec() }
+func (v evidenceValidator) TokensFromSharesRoundUp(sdk.Dec) sdk.Dec   { return sdk.ZeroDec() }
+
+func (v evidenceValidator) SharesFromTokens(sdk.Int) (sdk.Dec, error) {
+	return sdk.ZeroDec(), fmt.Errorf("validators have no delegator shares")
+}
+
+func (v evidenceValidator) SharesFromTokensTruncated(sdk.Int) (sdk.Dec, error) {
+	return sdk.ZeroDec(), fmt.Errorf("validators have no delegator shares")
+}
@@ -1,20 +1,43 @@
 package keeper
 
 import (
+	"strings"
+
 	"github.com/KiraCore/sekai/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Keeper represents the keeper that maintains the Validator Registry.
 type Keeper struct {
-	storeKey sdk.StoreKey
-	cdc      *codec.LegacyAmino
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryMarshaler
+	paramSpace paramtypes.Subspace
 }
 
 // NewKeeper returns new keeper.
-func NewKeeper(storeKey sdk.StoreKey, cdc *codec.LegacyAmino) Keeper {
-	return Keeper{storeKey: storeKey, cdc: cdc}
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{storeKey: storeKey, cdc: cdc, paramSpace: paramSpace}
+}
+
+// GetParams returns the custom staking parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the custom staking parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
 }
 
 func (k Keeper) AddValidator(ctx sdk.Context, validator types.Validator) {
@@ -24,6 +47,18 @@ func (k Keeper) AddValidator(ctx sdk.Context, validator types.Validator) {
 
 	// Save by moniker
 	store.Set(types.GetValidatorByMonikerKey(validator.Moniker), types.GetValidatorKey(validator.ValKey))
+
+	// Save by consensus address
+	store.Set(types.GetValidatorByConsAddressKey(validator.GetConsAddr()), types.GetValidatorKey(validator.ValKey))
+}
+
+// RemoveValidator deletes a validator and its indexes. It leaves the
+// Tendermint set with the next validator set updates.
+func (k Keeper) RemoveValidator(ctx sdk.Context, validator types.Validator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorKey(validator.ValKey))
+	store.Delete(types.GetValidatorByMonikerKey(validator.Moniker))
+	store.Delete(types.GetValidatorByConsAddressKey(validator.GetConsAddr()))
 }
 
 func (k Keeper) GetValidator(ctx sdk.Context, address sdk.ValAddress) types.Validator {
@@ -42,6 +77,48 @@ func (k Keeper) GetValidatorByMoniker(ctx sdk.Context, moniker string) types.Val
 	return k.getValidatorByKey(ctx, valKey)
 }
 
+// GetValidatorByConsAddress returns the validator signing blocks with a
+// consensus address.
+func (k Keeper) GetValidatorByConsAddress(ctx sdk.Context, consAddr sdk.ConsAddress) (types.Validator, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	valKey := store.Get(types.GetValidatorByConsAddressKey(consAddr))
+	if valKey == nil {
+		return types.Validator{}, false
+	}
+
+	return k.getValidatorByKey(ctx, valKey), true
+}
+
+// HasValidator reports whether there is a validator with the address.
+func (k Keeper) HasValidator(ctx sdk.Context, address sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorKey(address))
+}
+
+// HasValidatorMoniker reports whether a validator uses the moniker.
+func (k Keeper) HasValidatorMoniker(ctx sdk.Context, moniker string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorByMonikerKey(moniker))
+}
+
+// HasValidatorConsAddress reports whether a validator signs blocks with the
+// consensus address.
+func (k Keeper) HasValidatorConsAddress(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorByConsAddressKey(consAddr))
+}
+
+// getExistingValidator returns a validator, or ErrValidatorNotFound when there
+// is none with the address.
+func (k Keeper) getExistingValidator(ctx sdk.Context, address sdk.ValAddress) (types.Validator, error) {
+	if !k.HasValidator(ctx, address) {
+		return types.Validator{}, types.ErrValidatorNotFound
+	}
+
+	return k.GetValidator(ctx, address), nil
+}
+
 func (k Keeper) getValidatorByKey(ctx sdk.Context, key []byte) types.Validator {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(key)
@@ -67,3 +144,73 @@ func (k Keeper) GetValidatorSet(ctx sdk.Context) []types.Validator {
 
 	return validators
 }
+
+// defaultValidatorsPageLimit is the page size used when a validators page request has no limit.
+const defaultValidatorsPageLimit = 100
+
+// GetValidatorsPage returns a page of the validators, ordered by address. A
+// nil status and an empty moniker prefix do not filter the validators.
+func (k Keeper) GetValidatorsPage(ctx sdk.Context, status *types.ValidatorStatus, monikerPrefix string, pageReq *query.PageRequest) ([]types.Validator, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorsKey)
+
+	matches := func(value []byte) (types.Validator, bool, error) {
+		var validator types.Validator
+		if err := k.cdc.UnmarshalBinaryBare(value, &validator); err != nil {
+			return types.Validator{}, false, err
+		}
+
+		if status != nil && validator.Status != *status {
+			return validator, false, nil
+		}
+
+		return validator, strings.HasPrefix(validator.Moniker, monikerPrefix), nil
+	}
+
+	// FilteredPaginate moves the next key past the page onto validators that
+	// are filtered out while it counts the total, so the page is read without
+	// the count and the matching validators are counted apart.
+	req := query.PageRequest{Limit: defaultValidatorsPageLimit, CountTotal: true}
+	if pageReq != nil {
+		req = *pageReq
+		if req.Limit == 0 {
+			req.Limit = defaultValidatorsPageLimit
+			req.CountTotal = true
+		}
+	}
+	countTotal := req.CountTotal && req.Key == nil
+	req.CountTotal = false
+
+	var validators []types.Validator
+	pageRes, err := query.FilteredPaginate(store, &req, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		validator, hit, err := matches(value)
+		if err != nil || !hit {
+			return false, err
+		}
+
+		if accumulate {
+			validators = append(validators, validator)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if countTotal {
+		iter := store.Iterator(nil, nil)
+		defer iter.Close()
+
+		for ; iter.Valid(); iter.Next() {
+			_, hit, err := matches(iter.Value())
+			if err != nil {
+				return nil, nil, err
+			}
+			if hit {
+				pageRes.Total++
+			}
+		}
+	}
+
+	return validators, pageRes, nil
+}
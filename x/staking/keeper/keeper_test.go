@@ -1,10 +1,16 @@
 package keeper_test
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"testing"
 
 	app2 "github.com/KiraCore/sekai/app"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/encoding"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/KiraCore/sekai/simapp"
@@ -92,3 +98,119 @@ func TestKeeper_GetValidatorSet(t *testing.T) {
 	validatorSet := app.CustomStakingKeeper.GetValidatorSet(ctx)
 	require.Equal(t, 2, len(validatorSet))
 }
+
+func TestKeeper_ApplyValidatorSetUpdates(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	pubKey1, pubKey2 := ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()
+	validator1, err := types.NewValidator("validator 1", "some-web.com", "A Social", "My Identity", types2.NewDec(1234), types2.ValAddress(pubKey1.Address()), pubKey1)
+	require.NoError(t, err)
+	validator2, err := types.NewValidator("validator 2", "some-web.com", "A Social", "My Identity", types2.NewDec(1234), types2.ValAddress(pubKey2.Address()), pubKey2)
+	require.NoError(t, err)
+
+	app.CustomStakingKeeper.AddValidator(ctx, validator1)
+	app.CustomStakingKeeper.AddValidator(ctx, validator2)
+
+	// New validators enter with the default power.
+	updates := app.CustomStakingKeeper.ApplyValidatorSetUpdates(ctx)
+	require.Len(t, updates, 2)
+	for _, update := range updates {
+		require.Equal(t, types.DefaultValidatorPower, update.Power)
+	}
+	require.Equal(t, types.DefaultValidatorPower, app.CustomStakingKeeper.GetLastValidatorPower(ctx, validator1.ValKey))
+
+	// Nothing changed, nothing to apply.
+	require.Empty(t, app.CustomStakingKeeper.ApplyValidatorSetUpdates(ctx))
+
+	_, err = app.CustomStakingKeeper.SetValidatorPower(ctx, validator2.ValKey, 10)
+	require.NoError(t, err)
+
+	pk2, err := encoding.PubKeyToProto(pubKey2)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: pk2, Power: 10}}, app.CustomStakingKeeper.ApplyValidatorSetUpdates(ctx))
+
+	_, err = app.CustomStakingKeeper.SetValidatorPower(ctx, validator2.ValKey, 0)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: pk2, Power: 0}}, app.CustomStakingKeeper.ApplyValidatorSetUpdates(ctx))
+	require.Equal(t, int64(0), app.CustomStakingKeeper.GetLastValidatorPower(ctx, validator2.ValKey))
+
+	// A removed validator leaves the set with power 0, once.
+	pk1, err := encoding.PubKeyToProto(pubKey1)
+	require.NoError(t, err)
+	app.CustomStakingKeeper.RemoveValidator(ctx, validator1)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: pk1, Power: 0}}, app.CustomStakingKeeper.ApplyValidatorSetUpdates(ctx))
+	require.Empty(t, app.CustomStakingKeeper.GetLastValidatorSet(ctx))
+	require.Empty(t, app.CustomStakingKeeper.ApplyValidatorSetUpdates(ctx))
+
+	_, err = app.CustomStakingKeeper.SetValidatorPower(ctx, types2.ValAddress("unknown"), 10)
+	require.Equal(t, types.ErrValidatorNotFound, err)
+
+	_, err = app.CustomStakingKeeper.SetValidatorPower(ctx, validator2.ValKey, -1)
+	require.Equal(t, types.ErrInvalidValidatorPower, err)
+
+	_, err = app.CustomStakingKeeper.SetValidatorPower(ctx, validator2.ValKey, types.MaxValidatorPower+1)
+	require.Equal(t, types.ErrInvalidValidatorPower, err)
+
+	// The validators together stay within the power Tendermint accepts.
+	app.CustomStakingKeeper.AddValidator(ctx, validator1)
+	_, err = app.CustomStakingKeeper.SetValidatorPower(ctx, validator2.ValKey, types.MaxValidatorPower)
+	require.True(t, errors.Is(err, types.ErrInvalidValidatorPower))
+	_, err = app.CustomStakingKeeper.SetValidatorPower(ctx, validator2.ValKey, types.MaxValidatorPower-validator1.Power)
+	require.NoError(t, err)
+}
+
+func TestKeeper_ApplyValidatorSetUpdates_MaxValidators(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	params := app.CustomStakingKeeper.GetParams(ctx)
+	params.MaxValidators = 2
+	app.CustomStakingKeeper.SetParams(ctx, params)
+
+	var validators []types.Validator
+	for i, power := range []int64{5, 3, 3} {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		validator, err := types.NewValidator(fmt.Sprintf("validator %d", i), "", "", "", types2.NewDecWithPrec(1, 1), types2.ValAddress(pubKey.Address()), pubKey)
+		require.NoError(t, err)
+		validator.Power = power
+		app.CustomStakingKeeper.AddValidator(ctx, validator)
+		validators = append(validators, validator)
+	}
+
+	// Validators with the same power are ranked by ValKey.
+	strongest, seated, waiting := validators[0], validators[1], validators[2]
+	if bytes.Compare(waiting.ValKey, seated.ValKey) < 0 {
+		seated, waiting = waiting, seated
+	}
+
+	updates := app.CustomStakingKeeper.ApplyValidatorSetUpdates(ctx)
+	require.Len(t, updates, 2)
+	require.Equal(t, []types.Validator{strongest, seated}, app.CustomStakingKeeper.GetTendermintValidators(ctx))
+	require.Equal(t, []types.Validator{waiting}, app.CustomStakingKeeper.GetWaitingValidators(ctx))
+	require.Equal(t, int64(0), app.CustomStakingKeeper.GetLastValidatorPower(ctx, waiting.ValKey))
+
+	// The waiting validator takes the seat freed up.
+	_, err := app.CustomStakingKeeper.Pause(ctx, strongest.ValKey)
+	require.NoError(t, err)
+
+	strongestPk, err := encoding.PubKeyToProto(strongest.GetConsPubKey())
+	require.NoError(t, err)
+	waitingPk, err := encoding.PubKeyToProto(waiting.GetConsPubKey())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		{PubKey: strongestPk, Power: 0},
+		{PubKey: waitingPk, Power: 3},
+	}, app.CustomStakingKeeper.ApplyValidatorSetUpdates(ctx))
+	require.Empty(t, app.CustomStakingKeeper.GetWaitingValidators(ctx))
+
+	// An outranking validator takes the seat of the weakest one.
+	_, err = app.CustomStakingKeeper.Unpause(ctx, strongest.ValKey)
+	require.NoError(t, err)
+	updates = app.CustomStakingKeeper.ApplyValidatorSetUpdates(ctx)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		{PubKey: strongestPk, Power: 5},
+		{PubKey: waitingPk, Power: 0},
+	}, updates)
+	require.Equal(t, []types.Validator{waiting}, app.CustomStakingKeeper.GetWaitingValidators(ctx))
+}
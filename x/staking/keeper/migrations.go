@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"github.com/KiraCore/sekai/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateAminoStore re-encodes the validators, the last validator set, the
+// signing infos and the infractions stored with the legacy amino codec to the
+// protobuf binary encoding of the keeper codec. The indexes, whitelist,
+// tombstones and unbonding queue store raw bytes and are left as they are.
+//
+// Params missing from the store are set to their defaults. A store without a
+// last validator set predates validator power: its validators were all
+// applied to Tendermint with power 1, so they get the default validator power
+// and are recorded in the last validator set with power 1.
+func (k Keeper) MigrateAminoStore(ctx sdk.Context, legacyAmino *codec.LegacyAmino) {
+	k.setMissingParams(ctx)
+	hasLastValidatorSet := k.hasLastValidatorSet(ctx)
+
+	k.migrateAminoPrefix(ctx, legacyAmino, types.ValidatorsKey, func() codec.ProtoMarshaler { return &types.Validator{} })
+	k.migrateAminoPrefix(ctx, legacyAmino, types.LastValidatorPowerKey, func() codec.ProtoMarshaler { return &types.LastValidatorPower{} })
+	k.migrateAminoPrefix(ctx, legacyAmino, types.ValidatorSigningInfoKey, func() codec.ProtoMarshaler { return &types.ValidatorSigningInfo{} })
+	k.migrateAminoPrefix(ctx, legacyAmino, types.InfractionsKey, func() codec.ProtoMarshaler { return &types.Infraction{} })
+
+	if !hasLastValidatorSet {
+		k.migratePowerlessValidators(ctx)
+	}
+}
+
+func (k Keeper) setMissingParams(ctx sdk.Context) {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
+func (k Keeper) hasLastValidatorSet(ctx sdk.Context) bool {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.LastValidatorPowerKey)
+	defer iter.Close()
+
+	return iter.Valid()
+}
+
+func (k Keeper) migratePowerlessValidators(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	for _, val := range k.GetValidatorSet(ctx) {
+		if val.Power == 0 {
+			val.Power = params.DefaultValidatorPower
+			k.AddValidator(ctx, val)
+		}
+
+		k.SetLastValidatorPower(ctx, types.LastValidatorPower{ValKey: val.ValKey, PubKey: val.PubKey, Power: 1})
+	}
+}
+
+func (k Keeper) migrateAminoPrefix(ctx sdk.Context, legacyAmino *codec.LegacyAmino, prefix []byte, newValue func() codec.ProtoMarshaler) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+
+	var keys, values [][]byte
+	for ; iter.Valid(); iter.Next() {
+		value := newValue()
+		legacyAmino.MustUnmarshalBinaryBare(iter.Value(), value)
+
+		keys = append(keys, iter.Key())
+		values = append(values, k.cdc.MustMarshalBinaryBare(value))
+	}
+	iter.Close()
+
+	for i, key := range keys {
+		store.Set(key, values[i])
+	}
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Pause takes an active validator out of the validator set for maintenance.
+func (k Keeper) Pause(ctx sdk.Context, address sdk.ValAddress) (types.Validator, error) {
+	return k.transitionValidatorStatus(ctx, address, types.Active, types.Paused)
+}
+
+// Unpause brings a paused validator back into the validator set.
+func (k Keeper) Unpause(ctx sdk.Context, address sdk.ValAddress) (types.Validator, error) {
+	return k.transitionValidatorStatus(ctx, address, types.Paused, types.Active)
+}
+
+// Inactivate takes an active validator out of the validator set until it is
+// activated again.
+func (k Keeper) Inactivate(ctx sdk.Context, address sdk.ValAddress) (types.Validator, error) {
+	return k.transitionValidatorStatus(ctx, address, types.Active, types.Inactive)
+}
+
+// Activate brings an inactive validator back into the validator set, or a
+// jailed one once its jail time is over.
+func (k Keeper) Activate(ctx sdk.Context, address sdk.ValAddress) (types.Validator, error) {
+	validator, err := k.getExistingValidator(ctx, address)
+	if err != nil {
+		return types.Validator{}, err
+	}
+
+	if validator.Status != types.Jailed {
+		return k.transitionValidatorStatus(ctx, address, types.Inactive, types.Active)
+	}
+
+	if ctx.BlockTime().Unix() < validator.JailedUntil {
+		return types.Validator{}, sdkerrors.Wrap(types.ErrValidatorJailed, fmt.Sprintf("jailed until %d", validator.JailedUntil))
+	}
+
+	validator.Status = types.Active
+	validator.JailedUntil = 0
+	k.AddValidator(ctx, validator)
+
+	return validator, nil
+}
+
+// transitionValidatorStatus moves a validator from one status to another. The
+// change reaches Tendermint with the next validator set updates.
+func (k Keeper) transitionValidatorStatus(ctx sdk.Context, address sdk.ValAddress, from, to types.ValidatorStatus) (types.Validator, error) {
+	validator, err := k.getExistingValidator(ctx, address)
+	if err != nil {
+		return types.Validator{}, err
+	}
+
+	if validator.Status != from {
+		return types.Validator{}, sdkerrors.Wrap(types.ErrInvalidStatusTransition, fmt.Sprintf("validator is %s, not %s", validator.Status, from))
+	}
+
+	validator.Status = to
+	k.AddValidator(ctx, validator)
+
+	return validator, nil
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ExitValidator takes a validator out of the validator set with the next
+// validator set updates, and schedules its removal once the unbonding time is
+// over. Until then the validator stays accountable for its infractions, and
+// its ValKey, moniker and consensus pubkey can not claim a seat again.
+func (k Keeper) ExitValidator(ctx sdk.Context, address sdk.ValAddress) (types.Validator, error) {
+	validator, err := k.getExistingValidator(ctx, address)
+	if err != nil {
+		return types.Validator{}, err
+	}
+
+	if validator.Status == types.Exiting {
+		return types.Validator{}, sdkerrors.Wrap(types.ErrValidatorExiting, fmt.Sprintf("unbonding until %d", validator.UnbondingUntil))
+	}
+
+	validator.Status = types.Exiting
+	validator.UnbondingUntil = ctx.BlockTime().Unix() + k.GetParams(ctx).UnbondingTime
+	k.AddValidator(ctx, validator)
+	k.InsertUnbondingQueue(ctx, validator)
+
+	k.deleteValidatorSigningInfo(ctx, validator.ValKey)
+
+	return validator, nil
+}
+
+// InsertUnbondingQueue schedules the removal of an exiting validator.
+func (k Keeper) InsertUnbondingQueue(ctx sdk.Context, validator types.Validator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUnbondingQueueKey(validator.UnbondingUntil, validator.ValKey), validator.ValKey)
+}
+
+// CompleteUnbondings removes the exiting validators whose unbonding time is
+// over, together with their indexes, and returns them.
+func (k Keeper) CompleteUnbondings(ctx sdk.Context) []types.Validator {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := store.Iterator(types.UnbondingQueueKey, types.GetUnbondingQueueTimePrefix(ctx.BlockTime().Unix()+1))
+
+	var keys [][]byte
+	var removed []types.Validator
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+
+		// Validators tombstoned while unbonding are already gone.
+		address := sdk.ValAddress(iter.Value())
+		if !k.HasValidator(ctx, address) {
+			continue
+		}
+
+		validator := k.GetValidator(ctx, address)
+		if validator.Status != types.Exiting {
+			continue
+		}
+
+		removed = append(removed, validator)
+	}
+	iter.Close()
+
+	for _, validator := range removed {
+		k.RemoveValidator(ctx, validator)
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return removed
+}
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/encoding"
+
+	"github.com/KiraCore/sekai/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetValidatorPower sets the voting power of a validator. It reaches
+// Tendermint with the next validator set updates.
+func (k Keeper) SetValidatorPower(ctx sdk.Context, address sdk.ValAddress, power int64) (types.Validator, error) {
+	validator, err := k.getExistingValidator(ctx, address)
+	if err != nil {
+		return types.Validator{}, err
+	}
+
+	if err := k.ValidateValidatorPower(ctx, address, power); err != nil {
+		return types.Validator{}, err
+	}
+
+	validator.Power = power
+	k.AddValidator(ctx, validator)
+
+	return validator, nil
+}
+
+// ValidateValidatorPower checks that the validator can have the power: it must
+// not be over the max validator power, and neither may the power of all the
+// validators together once the validator has it.
+func (k Keeper) ValidateValidatorPower(ctx sdk.Context, address sdk.ValAddress, power int64) error {
+	if power < 0 || power > types.MaxValidatorPower {
+		return types.ErrInvalidValidatorPower
+	}
+
+	total := power
+	for _, val := range k.GetValidatorSet(ctx) {
+		if val.ValKey.Equals(address) {
+			continue
+		}
+
+		total += val.Power
+		if total > types.MaxValidatorPower {
+			return sdkerrors.Wrapf(types.ErrInvalidValidatorPower, "total validator power over %d", types.MaxValidatorPower)
+		}
+	}
+
+	return nil
+}
+
+// GetRankedValidators returns the validators with consensus power, strongest
+// first. Validators with the same power are ranked by ValKey.
+func (k Keeper) GetRankedValidators(ctx sdk.Context) []types.Validator {
+	var ranked []types.Validator
+	for _, val := range k.GetValidatorSet(ctx) {
+		if val.ConsensusPower() > 0 {
+			ranked = append(ranked, val)
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].ConsensusPower() != ranked[j].ConsensusPower() {
+			return ranked[i].ConsensusPower() > ranked[j].ConsensusPower()
+		}
+
+		return bytes.Compare(ranked[i].ValKey, ranked[j].ValKey) < 0
+	})
+
+	return ranked
+}
+
+// GetTendermintValidators returns the max validators best ranked validators,
+// the ones making up the Tendermint validator set.
+func (k Keeper) GetTendermintValidators(ctx sdk.Context) []types.Validator {
+	ranked := k.GetRankedValidators(ctx)
+
+	maxValidators := k.GetParams(ctx).MaxValidators
+	if int64(len(ranked)) > maxValidators {
+		ranked = ranked[:maxValidators]
+	}
+
+	return ranked
+}
+
+// GetWaitingValidators returns the validators with consensus power ranked
+// below the max validators, best ranked first. They enter the Tendermint
+// validator set as seats free up.
+func (k Keeper) GetWaitingValidators(ctx sdk.Context) []types.Validator {
+	ranked := k.GetRankedValidators(ctx)
+
+	maxValidators := k.GetParams(ctx).MaxValidators
+	if int64(len(ranked)) <= maxValidators {
+		return nil
+	}
+
+	return ranked[maxValidators:]
+}
+
+// ApplyValidatorSetUpdates compares the consensus power of the max validators
+// best ranked validators with the set last applied to Tendermint and returns
+// only the difference: validators that joined or changed power, and
+// validators that left, stopped being active or were outranked with power 0.
+// The new set is recorded as applied.
+func (k Keeper) ApplyValidatorSetUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
+	lastSet := k.GetLastValidatorSet(ctx)
+
+	powers := make(map[string]int64)
+	for _, val := range k.GetTendermintValidators(ctx) {
+		powers[val.ValKey.String()] = val.ConsensusPower()
+	}
+
+	lastPowers := make(map[string]int64, len(lastSet))
+	for _, last := range lastSet {
+		lastPowers[last.ValKey.String()] = last.Power
+	}
+
+	var valUpdates []abci.ValidatorUpdate
+	for _, val := range k.GetValidatorSet(ctx) {
+		lastPower := lastPowers[val.ValKey.String()]
+		delete(lastPowers, val.ValKey.String())
+
+		power := powers[val.ValKey.String()]
+		if power == lastPower {
+			continue
+		}
+
+		valUpdates = append(valUpdates, validatorUpdate(val.PubKey, power))
+		k.SetLastValidatorPower(ctx, types.LastValidatorPower{ValKey: val.ValKey, PubKey: val.PubKey, Power: power})
+	}
+
+	// Whatever is left of the last set is no longer stored.
+	for _, last := range lastSet {
+		if _, removed := lastPowers[last.ValKey.String()]; !removed {
+			continue
+		}
+
+		valUpdates = append(valUpdates, validatorUpdate(last.PubKey, 0))
+		k.SetLastValidatorPower(ctx, types.LastValidatorPower{ValKey: last.ValKey, PubKey: last.PubKey})
+	}
+
+	return valUpdates
+}
+
+func validatorUpdate(pubKey string, power int64) abci.ValidatorUpdate {
+	pk, err := encoding.PubKeyToProto(sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, pubKey))
+	if err != nil {
+		panic("invalid key")
+	}
+
+	return abci.ValidatorUpdate{
+		Power:  power,
+		PubKey: pk,
+	}
+}
+
+// GetLastValidatorPower returns the power last applied to Tendermint for a
+// validator, zero if it is not in the Tendermint validator set.
+func (k Keeper) GetLastValidatorPower(ctx sdk.Context, address sdk.ValAddress) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLastValidatorPowerKey(address))
+	if bz == nil {
+		return 0
+	}
+
+	var last types.LastValidatorPower
+	k.cdc.MustUnmarshalBinaryBare(bz, &last)
+
+	return last.Power
+}
+
+// SetLastValidatorPower records the power applied to Tendermint for a
+// validator. A validator applied with power 0 left the Tendermint set.
+func (k Keeper) SetLastValidatorPower(ctx sdk.Context, last types.LastValidatorPower) {
+	store := ctx.KVStore(k.storeKey)
+	if last.Power == 0 {
+		store.Delete(types.GetLastValidatorPowerKey(last.ValKey))
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&last)
+	store.Set(types.GetLastValidatorPowerKey(last.ValKey), bz)
+}
+
+// GetLastValidatorSet returns the validator set last applied to Tendermint.
+func (k Keeper) GetLastValidatorSet(ctx sdk.Context) []types.LastValidatorPower {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.LastValidatorPowerKey)
+	defer iter.Close()
+
+	var lastSet []types.LastValidatorPower
+	for ; iter.Valid(); iter.Next() {
+		var last types.LastValidatorPower
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &last)
+		lastSet = append(lastSet, last)
+	}
+
+	return lastSet
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/KiraCore/sekai/x/staking/types"
+)
+
+// SetWhitelisted adds a ValKey to or removes it from the whitelist of
+// addresses allowed to claim a validator seat.
+func (k Keeper) SetWhitelisted(ctx sdk.Context, valKey sdk.ValAddress, whitelisted bool) {
+	store := ctx.KVStore(k.storeKey)
+	if whitelisted {
+		store.Set(types.GetWhitelistKey(valKey), []byte{1})
+	} else {
+		store.Delete(types.GetWhitelistKey(valKey))
+	}
+}
+
+// IsWhitelisted reports whether a ValKey is allowed to claim a validator seat.
+func (k Keeper) IsWhitelisted(ctx sdk.Context, valKey sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetWhitelistKey(valKey))
+}
+
+// GetWhitelist returns the ValKeys allowed to claim a validator seat.
+func (k Keeper) GetWhitelist(ctx sdk.Context) []sdk.ValAddress {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.WhitelistKey)
+	defer iter.Close()
+
+	var whitelist []sdk.ValAddress
+	for ; iter.Valid(); iter.Next() {
+		whitelist = append(whitelist, sdk.ValAddress(iter.Key()[len(types.WhitelistKey):]))
+	}
+
+	return whitelist
+}
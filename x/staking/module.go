@@ -1,9 +1,9 @@
 package staking
 
 import (
+	"context"
 	"encoding/json"
-
-	"github.com/tendermint/tendermint/crypto/encoding"
+	"fmt"
 
 	"github.com/KiraCore/sekai/x/staking/keeper"
 	"github.com/KiraCore/sekai/x/staking/types"
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/gogo/protobuf/grpc"
 	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -37,25 +38,38 @@ func (b AppModuleBasic) Name() string {
 }
 
 func (b AppModuleBasic) RegisterInterfaces(registry types2.InterfaceRegistry) {
+	cumstomtypes.RegisterInterfaces(registry)
 }
 
 func (b AppModuleBasic) DefaultGenesis(marshaler codec.JSONMarshaler) json.RawMessage {
-	return nil
+	return marshaler.MustMarshalJSON(types.DefaultGenesis())
 }
 
 func (b AppModuleBasic) ValidateGenesis(marshaler codec.JSONMarshaler, config client.TxEncodingConfig, message json.RawMessage) error {
-	return nil
+	var genesisState types.GenesisState
+	if err := marshaler.UnmarshalJSON(message, &genesisState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return types.ValidateGenesis(genesisState)
 }
 
-func (b AppModuleBasic) RegisterRESTRoutes(context client.Context, router *mux.Router) {
+// RegisterRESTRoutes serves the gRPC query service through the gRPC gateway.
+func (b AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, router *mux.Router) {
+	gwmux := runtime.NewServeMux()
+	if err := cumstomtypes.RegisterQueryHandlerClient(context.Background(), gwmux, cumstomtypes.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+
+	router.PathPrefix("/kira/staking/").Handler(gwmux)
 }
 
 func (b AppModuleBasic) GetTxCmd() *cobra.Command {
-	return cli.GetTxClaimValidatorCmd()
+	return cli.GetTxCmd()
 }
 
 func (b AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return cli.GetCmdQueryValidatorByAddress()
+	return cli.GetQueryCmd()
 }
 
 // AppModule extends the cosmos SDK staking.
@@ -76,25 +90,12 @@ func (am AppModule) InitGenesis(
 	var genesisState types.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 
-	valUpdate := make([]abci.ValidatorUpdate, len(genesisState.Validators))
-
-	for i, val := range genesisState.Validators {
-		am.customStakingKeeper.AddValidator(ctx, val)
-		pk, err := encoding.PubKeyToProto(val.GetConsPubKey())
-		if err != nil {
-			panic("invalid key")
-		}
-		valUpdate[i] = abci.ValidatorUpdate{
-			Power:  1,
-			PubKey: pk,
-		}
-	}
-
-	return valUpdate
+	return InitGenesis(ctx, am.customStakingKeeper, genesisState)
 }
 
-func (am AppModule) ExportGenesis(context sdk.Context, marshaler codec.JSONMarshaler) json.RawMessage {
-	return nil
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json.RawMessage {
+	genesisState := ExportGenesis(ctx, am.customStakingKeeper)
+	return cdc.MustMarshalJSON(genesisState)
 }
 
 func (am AppModule) RegisterInvariants(registry sdk.InvariantRegistry) {}
@@ -105,26 +106,12 @@ func (am AppModule) LegacyQuerierHandler(marshaler codec.JSONMarshaler) sdk.Quer
 	return nil
 }
 
-func (am AppModule) BeginBlock(context sdk.Context, block abci.RequestBeginBlock) {}
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	BeginBlocker(ctx, req, am.customStakingKeeper)
+}
 
 func (am AppModule) EndBlock(ctx sdk.Context, block abci.RequestEndBlock) []abci.ValidatorUpdate {
-	valSet := am.customStakingKeeper.GetValidatorSet(ctx)
-
-	valUpdate := make([]abci.ValidatorUpdate, len(valSet))
-
-	for i, val := range valSet {
-		am.customStakingKeeper.AddValidator(ctx, val)
-		proto, err := encoding.PubKeyToProto(val.GetConsPubKey())
-		if err != nil {
-			panic("invalid key")
-		}
-		valUpdate[i] = abci.ValidatorUpdate{
-			Power:  1,
-			PubKey: proto,
-		}
-	}
-
-	return valUpdate
+	return EndBlocker(ctx, am.customStakingKeeper)
 }
 
 func (am AppModule) Name() string {
@@ -0,0 +1,34 @@
+package staking
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	customkeeper "github.com/KiraCore/sekai/x/staking/keeper"
+	"github.com/KiraCore/sekai/x/staking/types"
+)
+
+// NewValidatorProposalHandler handles the governance proposals that manage validators.
+func NewValidatorProposalHandler(k customkeeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetValidatorPowerProposal:
+			return handleSetValidatorPowerProposal(ctx, k, c)
+		case *types.SetValidatorWhitelistProposal:
+			return handleSetValidatorWhitelistProposal(ctx, k, c)
+		default:
+			return errors.Wrapf(errors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+func handleSetValidatorPowerProposal(ctx sdk.Context, k customkeeper.Keeper, p *types.SetValidatorPowerProposal) error {
+	_, err := k.SetValidatorPower(ctx, p.ValKey, p.Power)
+	return err
+}
+
+func handleSetValidatorWhitelistProposal(ctx sdk.Context, k customkeeper.Keeper, p *types.SetValidatorWhitelistProposal) error {
+	k.SetWhitelisted(ctx, p.ValKey, p.Whitelisted)
+	return nil
+}
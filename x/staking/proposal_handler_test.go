@@ -0,0 +1,134 @@
+package staking_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/staking"
+	customtypes "github.com/KiraCore/sekai/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+func TestValidatorProposalHandler_SetValidatorPower(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	validator, err := customtypes.NewValidator("aMoniker", "some-web.com", "A Social", "My Identity", types.NewDec(1234), types.ValAddress(pubKey.Address()), pubKey)
+	require.NoError(t, err)
+	app.CustomStakingKeeper.AddValidator(ctx, validator)
+
+	handler := staking.NewValidatorProposalHandler(app.CustomStakingKeeper)
+
+	err = handler(ctx, customtypes.NewSetValidatorPowerProposal("title", "description", types.ValAddress("unknown"), 5))
+	require.Equal(t, customtypes.ErrValidatorNotFound, err)
+
+	err = handler(ctx, customtypes.NewSetValidatorPowerProposal("title", "description", validator.ValKey, 5))
+	require.NoError(t, err)
+	require.Equal(t, int64(5), app.CustomStakingKeeper.GetValidator(ctx, validator.ValKey).Power)
+}
+
+func TestValidatorProposalHandler_SetValidatorWhitelist(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 1})
+
+	params := app.CustomStakingKeeper.GetParams(ctx)
+	params.WhitelistEnabled = true
+	app.CustomStakingKeeper.SetParams(ctx, params)
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	valKey := types.ValAddress(pubKey.Address())
+	msg, err := customtypes.NewMsgClaimValidator("aMoniker", "some-web.com", "A Social", "My Identity", types.NewDecWithPrec(1, 1), valKey, pubKey)
+	require.NoError(t, err)
+
+	handler := staking.NewValidatorProposalHandler(app.CustomStakingKeeper)
+	msgHandler := staking.NewHandler(app.CustomStakingKeeper)
+
+	_, err = msgHandler(ctx, msg)
+	require.Equal(t, customtypes.ErrValidatorNotWhitelisted, err)
+
+	err = handler(ctx, customtypes.NewSetValidatorWhitelistProposal("title", "description", valKey, true))
+	require.NoError(t, err)
+	require.True(t, app.CustomStakingKeeper.IsWhitelisted(ctx, valKey))
+
+	err = handler(ctx, customtypes.NewSetValidatorWhitelistProposal("title", "description", valKey, false))
+	require.NoError(t, err)
+	require.False(t, app.CustomStakingKeeper.IsWhitelisted(ctx, valKey))
+
+	_, err = msgHandler(ctx, msg)
+	require.Equal(t, customtypes.ErrValidatorNotWhitelisted, err)
+
+	require.NoError(t, handler(ctx, customtypes.NewSetValidatorWhitelistProposal("title", "description", valKey, true)))
+	_, err = msgHandler(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, valKey, app.CustomStakingKeeper.GetValidator(ctx, valKey).ValKey)
+
+	// Without the whitelist anyone can claim a seat.
+	params.WhitelistEnabled = false
+	app.CustomStakingKeeper.SetParams(ctx, params)
+
+	pubKey2 := ed25519.GenPrivKey().PubKey()
+	msg, err = customtypes.NewMsgClaimValidator("another", "", "", "", types.NewDecWithPrec(1, 1), types.ValAddress(pubKey2.Address()), pubKey2)
+	require.NoError(t, err)
+	_, err = msgHandler(ctx, msg)
+	require.NoError(t, err)
+}
+
+func TestParamChangeProposal_CustomStakingParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	handler := params.NewParamChangeProposalHandler(app.ParamsKeeper)
+	err := handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(customtypes.ModuleName, string(customtypes.ParamStoreKeyMaxFieldLength), `"8"`),
+		paramproposal.NewParamChange(customtypes.ModuleName, string(customtypes.ParamStoreKeyMaxCommission), `"0.200000000000000000"`),
+		paramproposal.NewParamChange(customtypes.ModuleName, string(customtypes.ParamStoreKeyDefaultValidatorPower), `"3"`),
+	}))
+	require.NoError(t, err)
+
+	err = handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(customtypes.ModuleName, string(customtypes.ParamStoreKeyMaxFieldLength), `"65"`),
+	}))
+	require.Error(t, err)
+
+	querier := staking.NewQuerier(app.CustomStakingKeeper)
+	res, err := querier.Params(types.WrapSDKContext(ctx), &customtypes.ParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(8), res.Params.MaxFieldLength)
+	require.Equal(t, types.NewDecWithPrec(2, 1), res.Params.MaxCommission)
+	require.Equal(t, int64(3), res.Params.DefaultValidatorPower)
+
+	msgHandler := staking.NewHandler(app.CustomStakingKeeper)
+	pubKey := ed25519.GenPrivKey().PubKey()
+	valKey := types.ValAddress(pubKey.Address())
+
+	tests := []struct {
+		name        string
+		moniker     string
+		commission  types.Dec
+		expectedErr error
+	}{
+		{"moniker longer than max field length", "too long moniker", types.NewDecWithPrec(1, 1), customtypes.ErrFieldTooLong},
+		{"commission above max commission", "moniker", types.NewDecWithPrec(3, 1), customtypes.ErrInvalidCommission},
+	}
+	for _, tt := range tests {
+		msg, err := customtypes.NewMsgClaimValidator(tt.moniker, "", "", "", tt.commission, valKey, pubKey)
+		require.NoError(t, err)
+
+		_, err = msgHandler(ctx, msg)
+		require.True(t, errors.Is(err, tt.expectedErr), tt.name)
+	}
+
+	msg, err := customtypes.NewMsgClaimValidator("moniker", "", "", "", types.NewDecWithPrec(1, 1), valKey, pubKey)
+	require.NoError(t, err)
+	_, err = msgHandler(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), app.CustomStakingKeeper.GetValidator(ctx, valKey).Power)
+}
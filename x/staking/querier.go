@@ -2,8 +2,10 @@ package staking
 
 import (
 	"context"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/KiraCore/sekai/x/staking/keeper"
 	"github.com/KiraCore/sekai/x/staking/types"
@@ -30,3 +32,42 @@ func (q Querier) ValidatorByMoniker(ctx context.Context, request *types.Validato
 		Validator: q.keeper.GetValidatorByMoniker(c, request.Moniker),
 	}, nil
 }
+
+func (q Querier) Infractions(ctx context.Context, request *types.InfractionsRequest) (*types.InfractionsResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	return &types.InfractionsResponse{
+		Infractions: q.keeper.GetInfractions(c, request.ValAddr),
+	}, nil
+}
+
+func (q Querier) Params(ctx context.Context, _ *types.ParamsRequest) (*types.ParamsResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	return &types.ParamsResponse{
+		Params: q.keeper.GetParams(c),
+	}, nil
+}
+
+func (q Querier) Validators(ctx context.Context, request *types.ValidatorsRequest) (*types.ValidatorsResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	var status *types.ValidatorStatus
+	if request.Status != "" {
+		value, ok := types.ValidatorStatus_value[strings.ToUpper(request.Status)]
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator status %s", request.Status)
+		}
+
+		s := types.ValidatorStatus(value)
+		status = &s
+	}
+
+	validators, pageRes, err := q.keeper.GetValidatorsPage(c, status, request.MonikerPrefix, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ValidatorsResponse{
+		Validators: validators,
+		Pagination: pageRes,
+	}, nil
+}
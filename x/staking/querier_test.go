@@ -3,6 +3,7 @@ package staking_test
 import (
 	"testing"
 
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/KiraCore/sekai/x/staking"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/KiraCore/sekai/simapp"
 	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,3 +37,97 @@ func TestQuerier_ValidatorByAddress(t *testing.T) {
 
 	require.Equal(t, val, qValidatorResp.Validator)
 }
+
+func TestQuerier_Validators(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	// Validators are stored in the order of the monikers, so the filtered out
+	// "other" follows the last matching validator of a page.
+	monikers := []string{"kira-1", "kira-2", "kira-3", "other"}
+	for i, moniker := range monikers {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		valAddr := types.ValAddress(append(make([]byte, 19), byte(i+1)))
+		val, err := types2.NewValidator(moniker, "Website", "Social", "identity", types.NewDecWithPrec(1, 1), valAddr, pubKey)
+		require.NoError(t, err)
+
+		if i == 0 {
+			val.Status = types2.Inactive
+		}
+
+		app.CustomStakingKeeper.AddValidator(ctx, val)
+	}
+
+	querier := staking.NewQuerier(app.CustomStakingKeeper)
+
+	tests := []struct {
+		name     string
+		request  *types2.ValidatorsRequest
+		expected []string
+	}{
+		{
+			name:     "all validators",
+			request:  &types2.ValidatorsRequest{},
+			expected: monikers,
+		},
+		{
+			name:     "by status",
+			request:  &types2.ValidatorsRequest{Status: "active"},
+			expected: []string{"kira-2", "kira-3", "other"},
+		},
+		{
+			name:     "by moniker prefix",
+			request:  &types2.ValidatorsRequest{MonikerPrefix: "kira"},
+			expected: []string{"kira-1", "kira-2", "kira-3"},
+		},
+		{
+			name:     "by status and moniker prefix",
+			request:  &types2.ValidatorsRequest{Status: "INACTIVE", MonikerPrefix: "kira"},
+			expected: []string{"kira-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := querier.Validators(types.WrapSDKContext(ctx), tt.request)
+			require.NoError(t, err)
+
+			var got []string
+			for _, val := range res.Validators {
+				got = append(got, val.Moniker)
+			}
+			require.ElementsMatch(t, tt.expected, got)
+			require.Equal(t, uint64(len(tt.expected)), res.Pagination.Total)
+		})
+	}
+
+	// Pages of filtered validators cover all of them once.
+	seen := make(map[string]bool)
+	var nextKey []byte
+	for {
+		res, err := querier.Validators(types.WrapSDKContext(ctx), &types2.ValidatorsRequest{
+			MonikerPrefix: "kira",
+			Pagination:    &query.PageRequest{Key: nextKey, Limit: 2, CountTotal: nextKey == nil},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.Validators), 2)
+		if nextKey == nil {
+			require.Equal(t, uint64(3), res.Pagination.Total)
+		}
+
+		for _, val := range res.Validators {
+			require.False(t, seen[val.Moniker])
+			seen[val.Moniker] = true
+		}
+
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.Len(t, seen, 3)
+
+	_, err := querier.Validators(types.WrapSDKContext(ctx), &types2.ValidatorsRequest{Status: "UNKNOWN"})
+	require.Error(t, err)
+}
@@ -4,15 +4,35 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgClaimValidator{}, "kiraHub/MsgClaimValidator", nil)
+	cdc.RegisterConcrete(&MsgPause{}, "kiraHub/MsgPause", nil)
+	cdc.RegisterConcrete(&MsgUnpause{}, "kiraHub/MsgUnpause", nil)
+	cdc.RegisterConcrete(&MsgActivate{}, "kiraHub/MsgActivate", nil)
+	cdc.RegisterConcrete(&MsgInactivate{}, "kiraHub/MsgInactivate", nil)
+	cdc.RegisterConcrete(&MsgEditValidator{}, "kiraHub/MsgEditValidator", nil)
+	cdc.RegisterConcrete(&MsgExitValidator{}, "kiraHub/MsgExitValidator", nil)
+	cdc.RegisterConcrete(&SetValidatorPowerProposal{}, "kiraHub/SetValidatorPowerProposal", nil)
+	cdc.RegisterConcrete(&SetValidatorWhitelistProposal{}, "kiraHub/SetValidatorWhitelistProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgClaimValidator{},
+		&MsgPause{},
+		&MsgUnpause{},
+		&MsgActivate{},
+		&MsgInactivate{},
+		&MsgEditValidator{},
+		&MsgExitValidator{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&SetValidatorPowerProposal{},
+		&SetValidatorWhitelistProposal{},
 	)
 }
 
@@ -1,8 +1,36 @@
 package types
 
-import "fmt"
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
 
-var ErrInvalidMonikerLength = fmt.Errorf("invalid moniker length (max 64 bytes)")
-var ErrInvalidWebsiteLength = fmt.Errorf("invalid website length (max 64 bytes)")
-var ErrInvalidSocialLength = fmt.Errorf("invalid social length (max 64 bytes)")
-var ErrInvalidIdentityLength = fmt.Errorf("invalid identity length (max 64 bytes)")
+// Errors rejecting a validator claiming or taking an identity already used by another one.
+var (
+	ErrValidatorMonikerExists = sdkerrors.Register(ModuleName, 2, "validator moniker already exists")
+	ErrValidatorKeyExists     = sdkerrors.Register(ModuleName, 3, "validator key already exists")
+	ErrValidatorPubKeyExists  = sdkerrors.Register(ModuleName, 4, "validator consensus pubkey already exists")
+)
+
+// Errors of the validator registry.
+var (
+	ErrInvalidValidatorPower    = sdkerrors.Register(ModuleName, 5, "invalid validator power (must be between 0 and the max validator power)")
+	ErrValidatorNotFound        = sdkerrors.Register(ModuleName, 6, "validator not found")
+	ErrInvalidValidatorStatus   = sdkerrors.Register(ModuleName, 7, "invalid validator status")
+	ErrInvalidStatusTransition  = sdkerrors.Register(ModuleName, 8, "invalid validator status transition")
+	ErrValidatorJailed          = sdkerrors.Register(ModuleName, 9, "validator jailed")
+	ErrValidatorTombstoned      = sdkerrors.Register(ModuleName, 10, "validator tombstoned for double signing")
+	ErrValidatorNotWhitelisted  = sdkerrors.Register(ModuleName, 11, "validator key not whitelisted to claim a seat")
+	ErrCommissionChangeTooSoon  = sdkerrors.Register(ModuleName, 12, "commission changed too recently")
+	ErrCommissionChangeTooLarge = sdkerrors.Register(ModuleName, 13, "commission change larger than the max commission change rate")
+	ErrInvalidCommission        = sdkerrors.Register(ModuleName, 14, "invalid commission (must be between 0 and 1)")
+	ErrValidatorExiting         = sdkerrors.Register(ModuleName, 15, "validator exiting")
+	ErrFieldTooLong             = sdkerrors.Register(ModuleName, 16, "validator field too long")
+)
+
+// Errors rejecting a validator field longer than MaxFieldLength.
+var (
+	ErrInvalidMonikerLength  = sdkerrors.Register(ModuleName, 17, "invalid moniker length (max 64 bytes)")
+	ErrInvalidWebsiteLength  = sdkerrors.Register(ModuleName, 18, "invalid website length (max 64 bytes)")
+	ErrInvalidSocialLength   = sdkerrors.Register(ModuleName, 19, "invalid social length (max 64 bytes)")
+	ErrInvalidIdentityLength = sdkerrors.Register(ModuleName, 20, "invalid identity length (max 64 bytes)")
+)
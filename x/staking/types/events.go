@@ -0,0 +1,10 @@
+package types
+
+// staking module event types
+const (
+	EventTypeJailValidator = "jail_validator"
+
+	AttributeKeyValidator    = "validator"
+	AttributeKeyMissedBlocks = "missed_blocks"
+	AttributeKeyJailedUntil  = "jailed_until"
+)
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new custom staking genesis state.
+func NewGenesisState(params Params, validators []Validator, whitelist []sdk.ValAddress, tombstones []sdk.ConsAddress, infractions []Infraction) *GenesisState {
+	return &GenesisState{
+		Params:      params,
+		Validators:  validators,
+		Whitelist:   whitelist,
+		Tombstones:  tombstones,
+		Infractions: infractions,
+	}
+}
+
+// DefaultGenesis returns the default custom staking genesis state. Validators
+// join through gentxs, so the registry starts empty.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Validator{}, []sdk.ValAddress{}, []sdk.ConsAddress{}, []Infraction{})
+}
+
+// ValidateGenesis validates the custom staking genesis state. Every validator
+// must be valid, fit the field length and commission params and have a parseable
+// consensus pubkey, and no two validators may share a moniker, a ValKey or a
+// consensus pubkey. The validators together may not have more than the max
+// validator power. Whitelisted ValKeys and tombstoned consensus addresses
+// must be set and unique, and no validator may sign with a tombstoned
+// consensus address. Infractions must have a ValKey and a positive height, at
+// most one per validator and height.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	tombstoned := make(map[string]bool, len(data.Tombstones))
+	for _, consAddr := range data.Tombstones {
+		if consAddr.Empty() {
+			return fmt.Errorf("empty tombstoned consensus address")
+		}
+
+		if tombstoned[consAddr.String()] {
+			return fmt.Errorf("duplicate tombstoned consensus address %s", consAddr)
+		}
+		tombstoned[consAddr.String()] = true
+	}
+
+	monikers := make(map[string]bool, len(data.Validators))
+	valKeys := make(map[string]bool, len(data.Validators))
+	pubKeys := make(map[string]bool, len(data.Validators))
+
+	var totalPower int64
+
+	for _, val := range data.Validators {
+		if err := val.Validate(); err != nil {
+			return fmt.Errorf("invalid validator %s: %w", val.Moniker, err)
+		}
+
+		if val.ValKey.Empty() {
+			return fmt.Errorf("validator %s has no ValKey", val.Moniker)
+		}
+
+		if err := data.Params.ValidateValidator(val); err != nil {
+			return fmt.Errorf("invalid validator %s: %w", val.Moniker, err)
+		}
+
+		pubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, val.PubKey)
+		if err != nil {
+			return fmt.Errorf("validator %s has an invalid consensus pubkey: %w", val.Moniker, err)
+		}
+
+		if tombstoned[sdk.ConsAddress(pubKey.Address()).String()] {
+			return fmt.Errorf("validator %s has a tombstoned consensus pubkey", val.Moniker)
+		}
+
+		if monikers[val.Moniker] {
+			return fmt.Errorf("duplicate validator moniker %s", val.Moniker)
+		}
+		monikers[val.Moniker] = true
+
+		if valKeys[val.ValKey.String()] {
+			return fmt.Errorf("duplicate validator ValKey %s", val.ValKey)
+		}
+		valKeys[val.ValKey.String()] = true
+
+		if pubKeys[val.PubKey] {
+			return fmt.Errorf("duplicate validator consensus pubkey %s", val.PubKey)
+		}
+		pubKeys[val.PubKey] = true
+
+		totalPower += val.Power
+		if totalPower > MaxValidatorPower {
+			return fmt.Errorf("total validator power over %d", MaxValidatorPower)
+		}
+	}
+
+	whitelisted := make(map[string]bool, len(data.Whitelist))
+	for _, valKey := range data.Whitelist {
+		if valKey.Empty() {
+			return fmt.Errorf("empty whitelisted ValKey")
+		}
+
+		if whitelisted[valKey.String()] {
+			return fmt.Errorf("duplicate whitelisted ValKey %s", valKey)
+		}
+		whitelisted[valKey.String()] = true
+	}
+
+	infractions := make(map[string]bool, len(data.Infractions))
+	for _, infraction := range data.Infractions {
+		if infraction.ValKey.Empty() {
+			return fmt.Errorf("infraction at %d has no ValKey", infraction.Height)
+		}
+
+		if infraction.Height <= 0 {
+			return fmt.Errorf("infraction of %s has a non-positive height %d", infraction.ValKey, infraction.Height)
+		}
+
+		key := string(GetInfractionKey(infraction.ValKey, infraction.Height))
+		if infractions[key] {
+			return fmt.Errorf("duplicate infraction of %s at %d", infraction.ValKey, infraction.Height)
+		}
+		infractions[key] = true
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,6 +26,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
 	Validators []Validator `protobuf:"bytes,1,rep,name=validators,proto3,casttype=Validator" json:"validators"`
+	Params     Params      `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// whitelist are the ValKeys allowed to claim a validator seat.
+	Whitelist []github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,3,rep,name=whitelist,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"whitelist,omitempty"`
+	// tombstones are the consensus addresses removed for double signing.
+	Tombstones []github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,4,rep,name=tombstones,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"tombstones,omitempty"`
+	// infractions are the double signs committed by the validators.
+	Infractions []Infraction `protobuf:"bytes,5,rep,name=infractions,proto3" json:"infractions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -67,6 +75,34 @@ func (m *GenesisState) GetValidators() []Validator {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetWhitelist() []github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Whitelist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTombstones() []github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.Tombstones
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInfractions() []Infraction {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kira.staking.GenesisState")
 }
@@ -74,20 +110,28 @@ func init() {
 func init() { proto.RegisterFile("genesis.proto", fileDescriptor_14205810582f3203) }
 
 var fileDescriptor_14205810582f3203 = []byte{
-	// 196 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4d, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xc9, 0xce, 0x2c, 0x4a, 0xd4,
-	0x2b, 0x2e, 0x49, 0xcc, 0xce, 0xcc, 0x4b, 0x97, 0xe2, 0x85, 0x32, 0x20, 0x92, 0x52, 0x22, 0xe9,
-	0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0x8a, 0xe2, 0xe2, 0x71, 0x87, 0x98,
-	0x11, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc5, 0xc5, 0x55, 0x96, 0x98, 0x93, 0x99, 0x92, 0x58,
-	0x92, 0x5f, 0x54, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xae, 0x87, 0x6c, 0xae, 0x5e,
-	0x18, 0x4c, 0xde, 0x49, 0xf0, 0xc4, 0x3d, 0x79, 0x86, 0x5f, 0xf7, 0xe4, 0x39, 0xe1, 0x42, 0x41,
-	0x48, 0xba, 0x9d, 0xdc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x27,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x39, 0xbf, 0x38, 0x37, 0xbf,
-	0x18, 0x4a, 0xe9, 0x16, 0xa7, 0x64, 0xeb, 0x57, 0xe8, 0x43, 0xad, 0xd2, 0x2f, 0xa9, 0x2c, 0x48,
-	0x2d, 0x4e, 0x62, 0x03, 0x3b, 0xd5, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x48, 0x0c, 0x26,
-	0xee, 0x00, 0x00, 0x00,
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x4f, 0x4f, 0xf2, 0x40,
+	0x10, 0xc6, 0x5b, 0xe0, 0x25, 0x61, 0x81, 0xc3, 0xdb, 0x90, 0xd8, 0x70, 0x68, 0x89, 0x27, 0x0e,
+	0xd2, 0xc6, 0xfa, 0x05, 0xb4, 0x26, 0x1a, 0xbd, 0x68, 0x6a, 0xe2, 0xc1, 0xdb, 0x42, 0xd7, 0xb2,
+	0x81, 0x76, 0xc8, 0xce, 0xf8, 0xef, 0x5b, 0xf8, 0xb1, 0x38, 0x72, 0xf4, 0xd4, 0x18, 0xf8, 0x0c,
+	0x5e, 0x38, 0x19, 0xda, 0x82, 0xe5, 0x62, 0x3c, 0xed, 0x93, 0x79, 0x66, 0x7e, 0x79, 0x66, 0x87,
+	0xb5, 0x23, 0x91, 0x08, 0x94, 0xe8, 0xcc, 0x14, 0x10, 0x18, 0xad, 0x89, 0x54, 0xdc, 0x41, 0xe2,
+	0x13, 0x99, 0x44, 0xdd, 0x76, 0x21, 0x72, 0xb3, 0xdb, 0x89, 0x20, 0x82, 0x4c, 0xba, 0x1b, 0x95,
+	0x57, 0x0f, 0xbf, 0x2a, 0xac, 0x75, 0x99, 0x43, 0xee, 0x88, 0x93, 0x30, 0xae, 0x19, 0x7b, 0xe6,
+	0x53, 0x19, 0x72, 0x02, 0x85, 0xa6, 0xde, 0xab, 0xf6, 0x9b, 0xde, 0x81, 0x53, 0x06, 0x3b, 0xf7,
+	0x5b, 0xdf, 0xff, 0x3f, 0x4f, 0x6d, 0x6d, 0x9d, 0xda, 0x8d, 0x5d, 0x29, 0x28, 0x4d, 0x1b, 0x1e,
+	0xab, 0xcf, 0xb8, 0xe2, 0x31, 0x9a, 0x95, 0x9e, 0xde, 0x6f, 0x7a, 0x9d, 0x7d, 0xce, 0x6d, 0xe6,
+	0xf9, 0xb5, 0x0d, 0x24, 0x28, 0x3a, 0x8d, 0x1b, 0xd6, 0x78, 0x19, 0x4b, 0x12, 0x53, 0x89, 0x64,
+	0x56, 0x7b, 0xd5, 0x7e, 0xcb, 0x3f, 0x5e, 0xa7, 0xf6, 0x20, 0x92, 0x34, 0x7e, 0x1a, 0x3a, 0x23,
+	0x88, 0xdd, 0x11, 0x60, 0x0c, 0x58, 0x3c, 0x03, 0x0c, 0x27, 0x2e, 0xbd, 0xcd, 0x04, 0x6e, 0x72,
+	0x9d, 0x85, 0xa1, 0x12, 0x88, 0xc1, 0x0f, 0xc3, 0x08, 0x18, 0x23, 0x88, 0x87, 0x48, 0x90, 0x08,
+	0x34, 0x6b, 0x19, 0xd1, 0x5b, 0xa7, 0xb6, 0xf3, 0x07, 0xe2, 0x39, 0x24, 0xb8, 0x45, 0x96, 0x28,
+	0xc6, 0x29, 0x6b, 0xca, 0xe4, 0x51, 0xf1, 0x11, 0x49, 0x48, 0xd0, 0xfc, 0x97, 0xfd, 0x92, 0xb9,
+	0xbf, 0xdd, 0xd5, 0xae, 0xa1, 0xd8, 0xb0, 0x3c, 0xe2, 0x5f, 0xcc, 0x97, 0x96, 0xbe, 0x58, 0x5a,
+	0xfa, 0xe7, 0xd2, 0xd2, 0xdf, 0x57, 0x96, 0xb6, 0x58, 0x59, 0xda, 0xc7, 0xca, 0xd2, 0x1e, 0x8e,
+	0x7e, 0xcd, 0xf5, 0xea, 0x16, 0xfc, 0x3c, 0xe1, 0xb0, 0x9e, 0x9d, 0xf1, 0xe4, 0x7b, 0x00, 0x37,
+	0xf9, 0x14, 0x49, 0x0a, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -110,6 +154,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Tombstones) > 0 {
+		for iNdEx := len(m.Tombstones) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tombstones[iNdEx])
+			copy(dAtA[i:], m.Tombstones[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Tombstones[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Whitelist) > 0 {
+		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Whitelist[iNdEx])
+			copy(dAtA[i:], m.Whitelist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Whitelist[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Validators) > 0 {
 		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -150,6 +236,26 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Whitelist) > 0 {
+		for _, b := range m.Whitelist {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Tombstones) > 0 {
+		for _, b := range m.Tombstones {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -222,6 +328,137 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, make([]byte, postIndex-iNdEx))
+			copy(m.Whitelist[len(m.Whitelist)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstones", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tombstones = append(m.Tombstones, make([]byte, postIndex-iNdEx))
+			copy(m.Tombstones[len(m.Tombstones)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infractions = append(m.Infractions, Infraction{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	types2 "github.com/KiraCore/sekai/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	newValidator := func(moniker string) types2.Validator {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		val, err := types2.NewValidator(moniker, "some-web.com", "A Social", "My Identity", types.NewDecWithPrec(1, 1), types.ValAddress(pubKey.Address()), pubKey)
+		require.NoError(t, err)
+		return val
+	}
+
+	val1, val2 := newValidator("validator1"), newValidator("validator2")
+
+	require.NoError(t, types2.ValidateGenesis(*types2.DefaultGenesis()))
+	require.NoError(t, types2.ValidateGenesis(*types2.NewGenesisState(types2.DefaultParams(), []types2.Validator{val1, val2}, nil, nil, nil)))
+
+	tests := []struct {
+		name     string
+		malleate func(val *types2.Validator)
+	}{
+		{"moniker longer than 64", func(val *types2.Validator) { val.Moniker = string(make([]byte, 65)) }},
+		{"duplicate moniker", func(val *types2.Validator) { val.Moniker = val1.Moniker }},
+		{"duplicate ValKey", func(val *types2.Validator) { val.ValKey = val1.ValKey }},
+		{"duplicate consensus pubkey", func(val *types2.Validator) { val.PubKey = val1.PubKey }},
+		{"invalid consensus pubkey", func(val *types2.Validator) { val.PubKey = "kiravalconspub1invalid" }},
+		{"missing ValKey", func(val *types2.Validator) { val.ValKey = nil }},
+		{"negative commission", func(val *types2.Validator) { val.Commission = types.NewDec(-1) }},
+		{"commission above one", func(val *types2.Validator) { val.Commission = types.NewDecWithPrec(11, 1) }},
+		{"power above max", func(val *types2.Validator) { val.Power = types2.MaxValidatorPower + 1 }},
+		{"total power above max", func(val *types2.Validator) { val.Power = types2.MaxValidatorPower }},
+	}
+	invalidParams := *types2.NewGenesisState(types2.DefaultParams(), []types2.Validator{val1}, nil, nil, nil)
+	invalidParams.Params.SignedBlocksWindow = 0
+	require.Error(t, types2.ValidateGenesis(invalidParams))
+
+	invalidParams.Params = types2.DefaultParams()
+	invalidParams.Params.MinCommission = types.NewDecWithPrec(5, 1)
+	invalidParams.Params.MaxCommission = types.NewDecWithPrec(4, 1)
+	require.Error(t, types2.ValidateGenesis(invalidParams))
+
+	invalidParams.Params = types2.DefaultParams()
+	invalidParams.Params.MaxFieldLength = types2.MaxFieldLength + 1
+	require.Error(t, types2.ValidateGenesis(invalidParams))
+
+	invalidParams.Params = types2.DefaultParams()
+	invalidParams.Params.DefaultValidatorPower = types2.MaxValidatorPower + 1
+	require.Error(t, types2.ValidateGenesis(invalidParams))
+
+	require.NoError(t, types2.ValidateGenesis(*types2.NewGenesisState(types2.DefaultParams(), nil, []types.ValAddress{val1.ValKey, val2.ValKey}, nil, nil)))
+	require.Error(t, types2.ValidateGenesis(*types2.NewGenesisState(types2.DefaultParams(), nil, []types.ValAddress{val1.ValKey, val1.ValKey}, nil, nil)))
+	require.Error(t, types2.ValidateGenesis(*types2.NewGenesisState(types2.DefaultParams(), nil, []types.ValAddress{nil}, nil, nil)))
+
+	consAddr1 := val1.GetConsAddr()
+	infraction := types2.Infraction{ValKey: val1.ValKey, Moniker: val1.Moniker, PubKey: val1.PubKey, Height: 5, Power: 1}
+	require.NoError(t, types2.ValidateGenesis(*types2.NewGenesisState(types2.DefaultParams(), []types2.Validator{val2}, nil, []types.ConsAddress{consAddr1}, []types2.Infraction{infraction})))
+	require.Error(t, types2.ValidateGenesis(*types2.NewGenesisState(types2.DefaultParams(), []types2.Validator{val1}, nil, []types.ConsAddress{consAddr1}, nil)))
+	require.Error(t, types2.ValidateGenesis(*types2.NewGenesisState(types2.DefaultParams(), nil, nil, []types.ConsAddress{consAddr1, consAddr1}, nil)))
+	require.Error(t, types2.ValidateGenesis(*types2.NewGenesisState(types2.DefaultParams(), nil, nil, []types.ConsAddress{nil}, nil)))
+	require.Error(t, types2.ValidateGenesis(*types2.NewGenesisState(types2.DefaultParams(), nil, nil, nil, []types2.Infraction{infraction, infraction})))
+
+	noValKey, noHeight := infraction, infraction
+	noValKey.ValKey = nil
+	noHeight.Height = 0
+	require.Error(t, types2.ValidateGenesis(*types2.NewGenesisState(types2.DefaultParams(), nil, nil, nil, []types2.Infraction{noValKey})))
+	require.Error(t, types2.ValidateGenesis(*types2.NewGenesisState(types2.DefaultParams(), nil, nil, nil, []types2.Infraction{noHeight})))
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			val := val2
+			tt.malleate(&val)
+			require.Error(t, types2.ValidateGenesis(*types2.NewGenesisState(types2.DefaultParams(), []types2.Validator{val1, val}, nil, nil, nil)))
+		})
+	}
+}
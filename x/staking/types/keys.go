@@ -6,12 +6,32 @@ const (
 	// ModuleName is the name of the custom staking
 	ModuleName = "customstaking"
 
+	// RouterKey to be used for routing msgs and proposals
+	RouterKey = ModuleName
+
 	ClaimValidator = "claim-validator"
+	Pause          = "pause"
+	Unpause        = "unpause"
+	Activate       = "activate"
+	Inactivate     = "inactivate"
+	EditValidator  = "edit-validator"
+	ExitValidator  = "exit-validator"
 )
 
 var (
 	ValidatorsKey          = []byte{0x21} // Validators key prefix.
 	ValidatorsByMonikerKey = []byte{0x22} // Validators by moniker prefix.
+	LastValidatorPowerKey  = []byte{0x23} // Power last applied to Tendermint by validator prefix.
+
+	ValidatorsByConsAddressKey      = []byte{0x24} // Validators by consensus address prefix.
+	ValidatorSigningInfoKey         = []byte{0x25} // Signing info by validator prefix.
+	ValidatorMissedBlockBitArrayKey = []byte{0x26} // Missed blocks bitmap by validator prefix.
+
+	InfractionsKey = []byte{0x27} // Double sign infractions by validator prefix.
+	TombstonesKey  = []byte{0x28} // Tombstoned consensus addresses prefix.
+	WhitelistKey   = []byte{0x29} // ValKeys allowed to claim a validator seat prefix.
+
+	UnbondingQueueKey = []byte{0x2A} // Exiting validators by unbonding end time prefix.
 )
 
 // GetValidatorKey gets the key for the validator with address
@@ -26,3 +46,54 @@ func GetValidatorKeyAcc(address sdk.AccAddress) []byte {
 func GetValidatorByMonikerKey(moniker string) []byte {
 	return append(ValidatorsByMonikerKey, []byte(moniker)...)
 }
+
+// GetLastValidatorPowerKey gets the key for the power last applied to Tendermint for the validator with address
+func GetLastValidatorPowerKey(operatorAddr sdk.ValAddress) []byte {
+	return append(LastValidatorPowerKey, operatorAddr.Bytes()...)
+}
+
+func GetValidatorByConsAddressKey(consAddr sdk.ConsAddress) []byte {
+	return append(ValidatorsByConsAddressKey, consAddr.Bytes()...)
+}
+
+func GetValidatorSigningInfoKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorSigningInfoKey, operatorAddr.Bytes()...)
+}
+
+// GetValidatorMissedBlockBitArrayPrefix gets the prefix of the missed blocks bitmap of the validator with address
+func GetValidatorMissedBlockBitArrayPrefix(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorMissedBlockBitArrayKey, append([]byte{byte(len(operatorAddr))}, operatorAddr.Bytes()...)...)
+}
+
+// GetValidatorMissedBlockBitArrayKey gets the key of a position of the missed blocks bitmap of the validator with address
+func GetValidatorMissedBlockBitArrayKey(operatorAddr sdk.ValAddress, index int64) []byte {
+	return append(GetValidatorMissedBlockBitArrayPrefix(operatorAddr), sdk.Uint64ToBigEndian(uint64(index))...)
+}
+
+// GetInfractionsPrefix gets the prefix of the infractions of the validator with address
+func GetInfractionsPrefix(operatorAddr sdk.ValAddress) []byte {
+	return append(InfractionsKey, append([]byte{byte(len(operatorAddr))}, operatorAddr.Bytes()...)...)
+}
+
+// GetInfractionKey gets the key of the infraction committed at height by the validator with address
+func GetInfractionKey(operatorAddr sdk.ValAddress, height int64) []byte {
+	return append(GetInfractionsPrefix(operatorAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func GetTombstoneKey(consAddr sdk.ConsAddress) []byte {
+	return append(TombstonesKey, consAddr.Bytes()...)
+}
+
+func GetWhitelistKey(operatorAddr sdk.ValAddress) []byte {
+	return append(WhitelistKey, operatorAddr.Bytes()...)
+}
+
+// GetUnbondingQueueTimePrefix gets the prefix of the validators unbonding until unixTime
+func GetUnbondingQueueTimePrefix(unixTime int64) []byte {
+	return append(UnbondingQueueKey, sdk.Uint64ToBigEndian(uint64(unixTime))...)
+}
+
+// GetUnbondingQueueKey gets the key of the validator with address unbonding until unixTime
+func GetUnbondingQueueKey(unixTime int64, operatorAddr sdk.ValAddress) []byte {
+	return append(GetUnbondingQueueTimePrefix(unixTime), operatorAddr.Bytes()...)
+}
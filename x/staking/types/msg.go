@@ -6,6 +6,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ sdk.Msg = &MsgClaimValidator{}
@@ -49,13 +50,44 @@ func (m MsgClaimValidator) Type() string {
 }
 
 func (m MsgClaimValidator) ValidateBasic() error {
+	if m.ValKey.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "validator not set")
+	}
+
+	if m.Moniker == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "moniker can not be empty")
+	}
+
+	if int64(len(m.Moniker)) > MaxFieldLength {
+		return ErrInvalidMonikerLength
+	}
+
+	if int64(len(m.Website)) > MaxFieldLength {
+		return ErrInvalidWebsiteLength
+	}
+
+	if int64(len(m.Social)) > MaxFieldLength {
+		return ErrInvalidSocialLength
+	}
+
+	if int64(len(m.Identity)) > MaxFieldLength {
+		return ErrInvalidIdentityLength
+	}
+
+	if m.Commission.IsNil() || m.Commission.IsNegative() || m.Commission.GT(sdk.OneDec()) {
+		return ErrInvalidCommission
+	}
+
+	if _, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, m.PubKey); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
 	return nil
 }
 
 func (m MsgClaimValidator) GetSignBytes() []byte {
-	//bz := ModuleCdc.MustMarshalJSON(m)
-	//return sdk.MustSortJSON(bz)
-	return nil
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
 }
 
 func (m MsgClaimValidator) GetSigners() []sdk.AccAddress {
@@ -63,3 +95,245 @@ func (m MsgClaimValidator) GetSigners() []sdk.AccAddress {
 		sdk.AccAddress(m.ValKey),
 	}
 }
+
+var _ sdk.Msg = &MsgPause{}
+
+func NewMsgPause(valKey sdk.ValAddress) *MsgPause {
+	return &MsgPause{
+		ValKey: valKey,
+	}
+}
+
+func (m MsgPause) Route() string {
+	return ModuleName
+}
+
+func (m MsgPause) Type() string {
+	return Pause
+}
+
+func (m MsgPause) ValidateBasic() error {
+	if m.ValKey.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "validator not set")
+	}
+
+	return nil
+}
+
+func (m MsgPause) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgPause) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.AccAddress(m.ValKey),
+	}
+}
+
+var _ sdk.Msg = &MsgUnpause{}
+
+func NewMsgUnpause(valKey sdk.ValAddress) *MsgUnpause {
+	return &MsgUnpause{
+		ValKey: valKey,
+	}
+}
+
+func (m MsgUnpause) Route() string {
+	return ModuleName
+}
+
+func (m MsgUnpause) Type() string {
+	return Unpause
+}
+
+func (m MsgUnpause) ValidateBasic() error {
+	if m.ValKey.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "validator not set")
+	}
+
+	return nil
+}
+
+func (m MsgUnpause) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgUnpause) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.AccAddress(m.ValKey),
+	}
+}
+
+var _ sdk.Msg = &MsgActivate{}
+
+func NewMsgActivate(valKey sdk.ValAddress) *MsgActivate {
+	return &MsgActivate{
+		ValKey: valKey,
+	}
+}
+
+func (m MsgActivate) Route() string {
+	return ModuleName
+}
+
+func (m MsgActivate) Type() string {
+	return Activate
+}
+
+func (m MsgActivate) ValidateBasic() error {
+	if m.ValKey.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "validator not set")
+	}
+
+	return nil
+}
+
+func (m MsgActivate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgActivate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.AccAddress(m.ValKey),
+	}
+}
+
+var _ sdk.Msg = &MsgInactivate{}
+
+func NewMsgInactivate(valKey sdk.ValAddress) *MsgInactivate {
+	return &MsgInactivate{
+		ValKey: valKey,
+	}
+}
+
+func (m MsgInactivate) Route() string {
+	return ModuleName
+}
+
+func (m MsgInactivate) Type() string {
+	return Inactivate
+}
+
+func (m MsgInactivate) ValidateBasic() error {
+	if m.ValKey.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "validator not set")
+	}
+
+	return nil
+}
+
+func (m MsgInactivate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgInactivate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.AccAddress(m.ValKey),
+	}
+}
+
+var _ sdk.Msg = &MsgExitValidator{}
+
+func NewMsgExitValidator(valKey sdk.ValAddress) *MsgExitValidator {
+	return &MsgExitValidator{
+		ValKey: valKey,
+	}
+}
+
+func (m MsgExitValidator) Route() string {
+	return ModuleName
+}
+
+func (m MsgExitValidator) Type() string {
+	return ExitValidator
+}
+
+func (m MsgExitValidator) ValidateBasic() error {
+	if m.ValKey.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "validator not set")
+	}
+
+	return nil
+}
+
+func (m MsgExitValidator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgExitValidator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.AccAddress(m.ValKey),
+	}
+}
+
+// DoNotModify is the value of the MsgEditValidator fields left unchanged.
+const DoNotModify = "[do-not-modify]"
+
+var _ sdk.Msg = &MsgEditValidator{}
+
+func NewMsgEditValidator(moniker, website, social, identity string, commission *sdk.Dec, valKey sdk.ValAddress) *MsgEditValidator {
+	return &MsgEditValidator{
+		Moniker:    moniker,
+		Website:    website,
+		Social:     social,
+		Identity:   identity,
+		Commission: commission,
+		ValKey:     valKey,
+	}
+}
+
+func (m MsgEditValidator) Route() string {
+	return ModuleName
+}
+
+func (m MsgEditValidator) Type() string {
+	return EditValidator
+}
+
+func (m MsgEditValidator) ValidateBasic() error {
+	if m.ValKey.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "validator not set")
+	}
+
+	if m.Moniker == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "moniker can not be empty")
+	}
+
+	if int64(len(m.Moniker)) > MaxFieldLength {
+		return ErrInvalidMonikerLength
+	}
+
+	if int64(len(m.Website)) > MaxFieldLength {
+		return ErrInvalidWebsiteLength
+	}
+
+	if int64(len(m.Social)) > MaxFieldLength {
+		return ErrInvalidSocialLength
+	}
+
+	if int64(len(m.Identity)) > MaxFieldLength {
+		return ErrInvalidIdentityLength
+	}
+
+	if m.Commission != nil && (m.Commission.IsNil() || m.Commission.IsNegative() || m.Commission.GT(sdk.OneDec())) {
+		return ErrInvalidCommission
+	}
+
+	return nil
+}
+
+func (m MsgEditValidator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgEditValidator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.AccAddress(m.ValKey),
+	}
+}
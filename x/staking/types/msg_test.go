@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/KiraCore/sekai/app"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 )
 
 func TestMsgClaimValidator_ValidateBasic(t *testing.T) {
@@ -44,3 +46,53 @@ func TestMsgClaimValidator_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgClaimValidator_ValidateBasicFields(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	valAddr := types.ValAddress(pubKey.Address())
+
+	newMsg := func() *types2.MsgClaimValidator {
+		msg, err := types2.NewMsgClaimValidator("me", "web", "social", "id", types.NewDecWithPrec(1, 1), valAddr, pubKey)
+		require.NoError(t, err)
+		return msg
+	}
+	require.NoError(t, newMsg().ValidateBasic())
+
+	tests := []struct {
+		name     string
+		malleate func(msg *types2.MsgClaimValidator)
+	}{
+		{"empty val key", func(msg *types2.MsgClaimValidator) { msg.ValKey = nil }},
+		{"empty moniker", func(msg *types2.MsgClaimValidator) { msg.Moniker = "" }},
+		{"moniker longer than 64", func(msg *types2.MsgClaimValidator) { msg.Moniker = strings.Repeat("a", 65) }},
+		{"website longer than 64", func(msg *types2.MsgClaimValidator) { msg.Website = strings.Repeat("a", 65) }},
+		{"social longer than 64", func(msg *types2.MsgClaimValidator) { msg.Social = strings.Repeat("a", 65) }},
+		{"identity longer than 64", func(msg *types2.MsgClaimValidator) { msg.Identity = strings.Repeat("a", 65) }},
+		{"nil commission", func(msg *types2.MsgClaimValidator) { msg.Commission = types.Dec{} }},
+		{"negative commission", func(msg *types2.MsgClaimValidator) { msg.Commission = types.NewDec(-1) }},
+		{"commission above one", func(msg *types2.MsgClaimValidator) { msg.Commission = types.NewDecWithPrec(11, 1) }},
+		{"invalid pub key", func(msg *types2.MsgClaimValidator) { msg.PubKey = "kiravalconspub1invalid" }},
+		{"account pub key", func(msg *types2.MsgClaimValidator) {
+			msg.PubKey = types.MustBech32ifyPubKey(types.Bech32PubKeyTypeAccPub, pubKey)
+		}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			msg := newMsg()
+			tt.malleate(msg)
+			require.Error(t, msg.ValidateBasic())
+		})
+	}
+}
+
+func TestMsgClaimValidator_GetSignBytes(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	msg, err := types2.NewMsgClaimValidator("me", "web", "social", "id", types.NewDecWithPrec(1, 1), types.ValAddress(pubKey.Address()), pubKey)
+	require.NoError(t, err)
+
+	bz := msg.GetSignBytes()
+	require.NotEmpty(t, bz)
+	require.Equal(t, types.MustSortJSON(bz), bz)
+	require.Contains(t, string(bz), `"moniker":"me"`)
+}
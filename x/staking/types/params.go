@@ -0,0 +1,240 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter keys
+var (
+	ParamStoreKeySignedBlocksWindow   = []byte("signedblockswindow")
+	ParamStoreKeyMinSignedPerWindow   = []byte("minsignedperwindow")
+	ParamStoreKeyDowntimeJailDuration = []byte("downtimejailduration")
+	ParamStoreKeyWhitelistEnabled     = []byte("whitelistenabled")
+
+	ParamStoreKeyMaxCommissionChangeRate = []byte("maxcommissionchangerate")
+	ParamStoreKeyCommissionChangePeriod  = []byte("commissionchangeperiod")
+	ParamStoreKeyUnbondingTime           = []byte("unbondingtime")
+	ParamStoreKeyMaxValidators           = []byte("maxvalidators")
+
+	ParamStoreKeyMaxFieldLength        = []byte("maxfieldlength")
+	ParamStoreKeyMinCommission         = []byte("mincommission")
+	ParamStoreKeyMaxCommission         = []byte("maxcommission")
+	ParamStoreKeyDefaultValidatorPower = []byte("defaultvalidatorpower")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default custom staking parameters
+func DefaultParams() Params {
+	return Params{
+		SignedBlocksWindow:   100,
+		MinSignedPerWindow:   sdk.NewDecWithPrec(5, 1), // 50%
+		DowntimeJailDuration: 600,                      // 10 minutes
+		WhitelistEnabled:     false,
+
+		MaxCommissionChangeRate: sdk.NewDecWithPrec(1, 2), // 1%
+		CommissionChangePeriod:  86400,                    // 1 day
+		UnbondingTime:           1814400,                  // 21 days
+		MaxValidators:           100,
+
+		MaxFieldLength:        MaxFieldLength,
+		MinCommission:         sdk.ZeroDec(),
+		MaxCommission:         sdk.OneDec(),
+		DefaultValidatorPower: DefaultValidatorPower,
+	}
+}
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeySignedBlocksWindow, &p.SignedBlocksWindow, validatePositiveInt64),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinSignedPerWindow, &p.MinSignedPerWindow, validateRate),
+		paramtypes.NewParamSetPair(ParamStoreKeyDowntimeJailDuration, &p.DowntimeJailDuration, validateNonNegativeInt64),
+		paramtypes.NewParamSetPair(ParamStoreKeyWhitelistEnabled, &p.WhitelistEnabled, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxCommissionChangeRate, &p.MaxCommissionChangeRate, validateRate),
+		paramtypes.NewParamSetPair(ParamStoreKeyCommissionChangePeriod, &p.CommissionChangePeriod, validateNonNegativeInt64),
+		paramtypes.NewParamSetPair(ParamStoreKeyUnbondingTime, &p.UnbondingTime, validateNonNegativeInt64),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxValidators, &p.MaxValidators, validatePositiveInt64),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxFieldLength, &p.MaxFieldLength, validateMaxFieldLength),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinCommission, &p.MinCommission, validateRate),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxCommission, &p.MaxCommission, validateRate),
+		paramtypes.NewParamSetPair(ParamStoreKeyDefaultValidatorPower, &p.DefaultValidatorPower, validateDefaultValidatorPower),
+	}
+}
+
+// Validate performs basic validation on custom staking parameters.
+func (p Params) Validate() error {
+	if err := validatePositiveInt64(p.SignedBlocksWindow); err != nil {
+		return fmt.Errorf("invalid signed blocks window: %w", err)
+	}
+	if err := validateRate(p.MinSignedPerWindow); err != nil {
+		return fmt.Errorf("invalid min signed per window: %w", err)
+	}
+	if err := validateNonNegativeInt64(p.DowntimeJailDuration); err != nil {
+		return fmt.Errorf("invalid downtime jail duration: %w", err)
+	}
+	if err := validateRate(p.MaxCommissionChangeRate); err != nil {
+		return fmt.Errorf("invalid max commission change rate: %w", err)
+	}
+	if err := validateNonNegativeInt64(p.CommissionChangePeriod); err != nil {
+		return fmt.Errorf("invalid commission change period: %w", err)
+	}
+	if err := validateNonNegativeInt64(p.UnbondingTime); err != nil {
+		return fmt.Errorf("invalid unbonding time: %w", err)
+	}
+	if err := validatePositiveInt64(p.MaxValidators); err != nil {
+		return fmt.Errorf("invalid max validators: %w", err)
+	}
+	if err := validateMaxFieldLength(p.MaxFieldLength); err != nil {
+		return fmt.Errorf("invalid max field length: %w", err)
+	}
+	if err := validateRate(p.MinCommission); err != nil {
+		return fmt.Errorf("invalid min commission: %w", err)
+	}
+	if err := validateRate(p.MaxCommission); err != nil {
+		return fmt.Errorf("invalid max commission: %w", err)
+	}
+	if p.MinCommission.GT(p.MaxCommission) {
+		return fmt.Errorf("min commission %s greater than max commission %s", p.MinCommission, p.MaxCommission)
+	}
+	if err := validateDefaultValidatorPower(p.DefaultValidatorPower); err != nil {
+		return fmt.Errorf("invalid default validator power: %w", err)
+	}
+
+	return nil
+}
+
+// MaxMissedBlocksPerWindow returns how many blocks of the signed blocks
+// window a validator can miss before it is jailed.
+func (p Params) MaxMissedBlocksPerWindow() int64 {
+	minSigned := p.MinSignedPerWindow.MulInt64(p.SignedBlocksWindow).RoundInt64()
+	return p.SignedBlocksWindow - minSigned
+}
+
+// ValidateValidator checks the field lengths and commission of a validator
+// against the params.
+func (p Params) ValidateValidator(v Validator) error {
+	for _, field := range []struct{ name, value string }{
+		{"moniker", v.Moniker},
+		{"website", v.Website},
+		{"social", v.Social},
+		{"identity", v.Identity},
+	} {
+		if err := p.ValidateField(field.name, field.value); err != nil {
+			return err
+		}
+	}
+
+	return p.ValidateCommission(v.Commission)
+}
+
+// ValidateField checks the length of a validator field against the params.
+func (p Params) ValidateField(name, value string) error {
+	if int64(len(value)) > p.MaxFieldLength {
+		return sdkerrors.Wrapf(ErrFieldTooLong, "%s longer than %d bytes", name, p.MaxFieldLength)
+	}
+
+	return nil
+}
+
+// ValidateCommission checks a validator commission against the params.
+func (p Params) ValidateCommission(commission sdk.Dec) error {
+	if commission.IsNil() || commission.LT(p.MinCommission) || commission.GT(p.MaxCommission) {
+		return sdkerrors.Wrapf(ErrInvalidCommission, "commission %s out of [%s, %s]", commission, p.MinCommission, p.MaxCommission)
+	}
+
+	return nil
+}
+
+func validatePositiveInt64(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateNonNegativeInt64(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("must not be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxFieldLength(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 || v > MaxFieldLength {
+		return fmt.Errorf("must be between 1 and %d: %d", MaxFieldLength, v)
+	}
+
+	return nil
+}
+
+func validateDefaultValidatorPower(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 || v > MaxValidatorPower {
+		return fmt.Errorf("must be between 1 and %d: %d", MaxValidatorPower, v)
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("rate must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("rate must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("rate too large: %s", v)
+	}
+
+	return nil
+}
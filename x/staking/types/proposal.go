@@ -0,0 +1,109 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetValidatorPower     = "SetValidatorPower"
+	ProposalTypeSetValidatorWhitelist = "SetValidatorWhitelist"
+)
+
+var (
+	_ govtypes.Content = &SetValidatorPowerProposal{}
+	_ govtypes.Content = &SetValidatorWhitelistProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetValidatorPower)
+	govtypes.RegisterProposalTypeCodec(&SetValidatorPowerProposal{}, "kiraHub/SetValidatorPowerProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetValidatorWhitelist)
+	govtypes.RegisterProposalTypeCodec(&SetValidatorWhitelistProposal{}, "kiraHub/SetValidatorWhitelistProposal")
+}
+
+func NewSetValidatorPowerProposal(title, description string, valKey sdk.ValAddress, power int64) *SetValidatorPowerProposal {
+	return &SetValidatorPowerProposal{
+		Title:       title,
+		Description: description,
+		ValKey:      valKey,
+		Power:       power,
+	}
+}
+
+func (p *SetValidatorPowerProposal) GetTitle() string { return p.Title }
+
+func (p *SetValidatorPowerProposal) GetDescription() string { return p.Description }
+
+func (p *SetValidatorPowerProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetValidatorPowerProposal) ProposalType() string { return ProposalTypeSetValidatorPower }
+
+func (p *SetValidatorPowerProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.ValKey.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "val key not set")
+	}
+
+	if p.Power < 0 || p.Power > MaxValidatorPower {
+		return ErrInvalidValidatorPower
+	}
+
+	return nil
+}
+
+func (p SetValidatorPowerProposal) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Set Validator Power Proposal:
+  Title:       %s
+  Description: %s
+  Val Key:     %s
+  Power:       %d
+`, p.Title, p.Description, p.ValKey, p.Power))
+}
+
+func NewSetValidatorWhitelistProposal(title, description string, valKey sdk.ValAddress, whitelisted bool) *SetValidatorWhitelistProposal {
+	return &SetValidatorWhitelistProposal{
+		Title:       title,
+		Description: description,
+		ValKey:      valKey,
+		Whitelisted: whitelisted,
+	}
+}
+
+func (p *SetValidatorWhitelistProposal) GetTitle() string { return p.Title }
+
+func (p *SetValidatorWhitelistProposal) GetDescription() string { return p.Description }
+
+func (p *SetValidatorWhitelistProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetValidatorWhitelistProposal) ProposalType() string {
+	return ProposalTypeSetValidatorWhitelist
+}
+
+func (p *SetValidatorWhitelistProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.ValKey.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "val key not set")
+	}
+
+	return nil
+}
+
+func (p SetValidatorWhitelistProposal) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Set Validator Whitelist Proposal:
+  Title:       %s
+  Description: %s
+  Val Key:     %s
+  Whitelisted: %t
+`, p.Title, p.Description, p.ValKey, p.Whitelisted))
+}
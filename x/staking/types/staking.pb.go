@@ -25,6 +25,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ValidatorStatus is the lifecycle status of a validator. Only active
+// validators are part of the Tendermint validator set.
+type ValidatorStatus int32
+
+const (
+	Active   ValidatorStatus = 0
+	Inactive ValidatorStatus = 1
+	Paused   ValidatorStatus = 2
+	Jailed   ValidatorStatus = 3
+	// EXITING validators left the validator set and are removed once their
+	// unbonding time is over.
+	Exiting ValidatorStatus = 4
+)
+
+var ValidatorStatus_name = map[int32]string{
+	0: "ACTIVE",
+	1: "INACTIVE",
+	2: "PAUSED",
+	3: "JAILED",
+	4: "EXITING",
+}
+
+var ValidatorStatus_value = map[string]int32{
+	"ACTIVE":   0,
+	"INACTIVE": 1,
+	"PAUSED":   2,
+	"JAILED":   3,
+	"EXITING":  4,
+}
+
+func (x ValidatorStatus) String() string {
+	return proto.EnumName(ValidatorStatus_name, int32(x))
+}
+
+func (ValidatorStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{0}
+}
+
 type MsgClaimValidator struct {
 	Moniker    string                                        `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
 	Website    string                                        `protobuf:"bytes,2,opt,name=website,proto3" json:"website,omitempty"`
@@ -118,6 +156,14 @@ type Validator struct {
 	Commission github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,5,opt,name=commission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission" yaml:"commission"`
 	ValKey     github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,6,opt,name=val_key,json=valKey,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"val_key,omitempty" yaml:"val_key"`
 	PubKey     string                                        `protobuf:"bytes,7,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty" yaml:"consensus_pubkey"`
+	Power      int64                                         `protobuf:"varint,8,opt,name=power,proto3" json:"power,omitempty"`
+	Status     ValidatorStatus                               `protobuf:"varint,9,opt,name=status,proto3,enum=kira.staking.ValidatorStatus" json:"status,omitempty" yaml:"status"`
+	// jailed_until is the unix time a jailed validator can be activated again at.
+	JailedUntil int64 `protobuf:"varint,10,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty" yaml:"jailed_until"`
+	// commission_updated_at is the unix time the commission was last set at.
+	CommissionUpdatedAt int64 `protobuf:"varint,11,opt,name=commission_updated_at,json=commissionUpdatedAt,proto3" json:"commission_updated_at,omitempty" yaml:"commission_updated_at"`
+	// unbonding_until is the unix time an exiting validator is removed at.
+	UnbondingUntil int64 `protobuf:"varint,12,opt,name=unbonding_until,json=unbondingUntil,proto3" json:"unbonding_until,omitempty" yaml:"unbonding_until"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -195,321 +241,3353 @@ func (m *Validator) GetPubKey() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgClaimValidator)(nil), "kira.staking.MsgClaimValidator")
-	proto.RegisterType((*Validator)(nil), "kira.staking.Validator")
+func (m *Validator) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("staking.proto", fileDescriptor_289e7c8aea278311) }
-
-var fileDescriptor_289e7c8aea278311 = []byte{
-	// 384 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x93, 0x3f, 0x4b, 0xfb, 0x40,
-	0x18, 0xc7, 0x93, 0xfe, 0xfa, 0x4b, 0xec, 0x51, 0x85, 0x06, 0xb1, 0xa1, 0x42, 0x52, 0x32, 0x48,
-	0x07, 0x9b, 0x0c, 0x3a, 0x75, 0x33, 0x15, 0x97, 0xe2, 0xd2, 0xa1, 0x83, 0x08, 0xe5, 0x92, 0x1c,
-	0xf1, 0xc8, 0x9f, 0x0b, 0xb9, 0x4b, 0x35, 0xef, 0xc2, 0x97, 0xe0, 0xcb, 0xe9, 0xd8, 0x51, 0x04,
-	0x83, 0xb4, 0x8b, 0x83, 0x53, 0x47, 0x27, 0xc9, 0x9f, 0xaa, 0x38, 0x88, 0x38, 0x3b, 0xdd, 0x7d,
-	0xef, 0x73, 0xf7, 0x3c, 0xdf, 0xfb, 0xc2, 0x03, 0xb6, 0x29, 0x83, 0x1e, 0x0e, 0x5d, 0x3d, 0x8a,
-	0x09, 0x23, 0x52, 0xd3, 0xc3, 0x31, 0xd4, 0xab, 0xb3, 0xce, 0xae, 0x4b, 0x5c, 0x52, 0x00, 0x23,
-	0xdf, 0x95, 0x77, 0xb4, 0xc7, 0x1a, 0x68, 0x9d, 0x53, 0x77, 0xe8, 0x43, 0x1c, 0x4c, 0xa0, 0x8f,
-	0x1d, 0xc8, 0x48, 0x2c, 0xc9, 0x40, 0x0c, 0x48, 0x88, 0x3d, 0x14, 0xcb, 0x7c, 0x97, 0xef, 0x35,
-	0xc6, 0x1b, 0x99, 0x93, 0x6b, 0x64, 0x51, 0xcc, 0x90, 0x5c, 0x2b, 0x49, 0x25, 0xa5, 0x3d, 0x20,
-	0x50, 0x62, 0x63, 0xe8, 0xcb, 0xff, 0x0a, 0x50, 0x29, 0xa9, 0x03, 0xb6, 0xb0, 0x83, 0x42, 0x86,
-	0x59, 0x2a, 0xd7, 0x0b, 0xf2, 0xae, 0x25, 0x1b, 0x00, 0x9b, 0x04, 0x01, 0xa6, 0x14, 0x93, 0x50,
-	0xfe, 0x9f, 0x53, 0x73, 0x38, 0xcf, 0x54, 0xee, 0x21, 0x53, 0x0f, 0x5c, 0xcc, 0xae, 0x12, 0x4b,
-	0xb7, 0x49, 0x60, 0xd8, 0x84, 0x06, 0x84, 0x56, 0x4b, 0x9f, 0x3a, 0x9e, 0xc1, 0xd2, 0x08, 0x51,
-	0xfd, 0x14, 0xd9, 0xeb, 0x4c, 0x6d, 0xa5, 0x30, 0xf0, 0x07, 0xda, 0x47, 0x25, 0x6d, 0xfc, 0xa9,
-	0xac, 0x74, 0x09, 0xc4, 0x19, 0xf4, 0xa7, 0x1e, 0x4a, 0x65, 0xa1, 0xcb, 0xf7, 0x9a, 0xe6, 0x70,
-	0x9d, 0xa9, 0x3b, 0xe5, 0x9b, 0x0a, 0x68, 0xaf, 0x99, 0xda, 0xff, 0x41, 0xbf, 0x09, 0xf4, 0x4f,
-	0x1c, 0x27, 0x46, 0x94, 0x8e, 0x85, 0x19, 0xf4, 0x47, 0x28, 0x95, 0xda, 0x40, 0x8c, 0x12, 0xab,
-	0xa8, 0x2e, 0x96, 0xff, 0x8e, 0x12, 0x6b, 0x84, 0xd2, 0x41, 0xfd, 0xf9, 0x4e, 0xe5, 0xb5, 0x97,
-	0x1a, 0x68, 0xfc, 0xe5, 0xfa, 0x8b, 0x5c, 0x8f, 0xbf, 0xe4, 0x6a, 0xee, 0xaf, 0x33, 0xb5, 0xbd,
-	0x71, 0x14, 0x52, 0x14, 0xd2, 0x84, 0x4e, 0xa3, 0xc4, 0xca, 0xdb, 0x6c, 0x42, 0x37, 0xcf, 0xe6,
-	0x4b, 0x85, 0x5f, 0x2c, 0x15, 0xfe, 0x69, 0xa9, 0xf0, 0xb7, 0x2b, 0x85, 0x5b, 0xac, 0x14, 0xee,
-	0x7e, 0xa5, 0x70, 0x17, 0x87, 0xdf, 0xda, 0xb8, 0x31, 0xaa, 0x31, 0x29, 0x0d, 0x59, 0x42, 0x31,
-	0x1d, 0x47, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x60, 0xcd, 0x03, 0x7f, 0x52, 0x03, 0x00, 0x00,
+func (m *Validator) GetStatus() ValidatorStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Active
 }
 
-func (this *MsgClaimValidator) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Validator) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
 	}
+	return 0
+}
 
-	that1, ok := that.(*MsgClaimValidator)
-	if !ok {
-		that2, ok := that.(MsgClaimValidator)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Moniker != that1.Moniker {
-		return false
+func (m *Validator) GetCommissionUpdatedAt() int64 {
+	if m != nil {
+		return m.CommissionUpdatedAt
 	}
-	if this.Website != that1.Website {
-		return false
+	return 0
+}
+
+func (m *Validator) GetUnbondingUntil() int64 {
+	if m != nil {
+		return m.UnbondingUntil
 	}
-	if this.Social != that1.Social {
-		return false
+	return 0
+}
+
+// MsgEditValidator edits the metadata and commission of a validator. Fields
+// set to "[do-not-modify]", and a nil commission, are left unchanged.
+type MsgEditValidator struct {
+	Moniker    string                                        `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Website    string                                        `protobuf:"bytes,2,opt,name=website,proto3" json:"website,omitempty"`
+	Social     string                                        `protobuf:"bytes,3,opt,name=social,proto3" json:"social,omitempty"`
+	Identity   string                                        `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	Commission *github_com_cosmos_cosmos_sdk_types.Dec       `protobuf:"bytes,5,opt,name=commission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission,omitempty" yaml:"commission"`
+	ValKey     github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,6,opt,name=val_key,json=valKey,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"val_key,omitempty" yaml:"val_key"`
+}
+
+func (m *MsgEditValidator) Reset()         { *m = MsgEditValidator{} }
+func (m *MsgEditValidator) String() string { return proto.CompactTextString(m) }
+func (*MsgEditValidator) ProtoMessage()    {}
+func (*MsgEditValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{2}
+}
+func (m *MsgEditValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEditValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEditValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Identity != that1.Identity {
-		return false
+}
+func (m *MsgEditValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditValidator.Merge(m, src)
+}
+func (m *MsgEditValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEditValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEditValidator proto.InternalMessageInfo
+
+func (m *MsgEditValidator) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
 	}
-	if !this.Commission.Equal(that1.Commission) {
-		return false
+	return ""
+}
+
+func (m *MsgEditValidator) GetWebsite() string {
+	if m != nil {
+		return m.Website
 	}
-	if !bytes.Equal(this.ValKey, that1.ValKey) {
-		return false
+	return ""
+}
+
+func (m *MsgEditValidator) GetSocial() string {
+	if m != nil {
+		return m.Social
 	}
-	if this.PubKey != that1.PubKey {
-		return false
+	return ""
+}
+
+func (m *MsgEditValidator) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return true
+	return ""
 }
-func (m *MsgClaimValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *MsgEditValidator) GetValKey() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgClaimValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgPause takes an active validator out of the validator set for maintenance.
+type MsgPause struct {
+	ValKey github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=val_key,json=valKey,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"val_key,omitempty" yaml:"val_key"`
 }
 
-func (m *MsgClaimValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ValKey) > 0 {
-		i -= len(m.ValKey)
-		copy(dAtA[i:], m.ValKey)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValKey)))
-		i--
-		dAtA[i] = 0x32
-	}
-	{
-		size := m.Commission.Size()
-		i -= size
-		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgPause) Reset()         { *m = MsgPause{} }
+func (m *MsgPause) String() string { return proto.CompactTextString(m) }
+func (*MsgPause) ProtoMessage()    {}
+func (*MsgPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{3}
+}
+func (m *MsgPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *MsgPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPause.Merge(m, src)
+}
+func (m *MsgPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPause proto.InternalMessageInfo
+
+func (m *MsgPause) GetValKey() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValKey
 	}
-	if len(m.Social) > 0 {
-		i -= len(m.Social)
-		copy(dAtA[i:], m.Social)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Social)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+// MsgUnpause brings a paused validator back into the validator set.
+type MsgUnpause struct {
+	ValKey github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=val_key,json=valKey,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"val_key,omitempty" yaml:"val_key"`
+}
+
+func (m *MsgUnpause) Reset()         { *m = MsgUnpause{} }
+func (m *MsgUnpause) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpause) ProtoMessage()    {}
+func (*MsgUnpause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{4}
+}
+func (m *MsgUnpause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgUnpause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpause.Merge(m, src)
+}
+func (m *MsgUnpause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpause proto.InternalMessageInfo
+
+func (m *MsgUnpause) GetValKey() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValKey
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+// MsgActivate brings an inactive validator back into the validator set.
+type MsgActivate struct {
+	ValKey github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=val_key,json=valKey,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"val_key,omitempty" yaml:"val_key"`
+}
+
+func (m *MsgActivate) Reset()         { *m = MsgActivate{} }
+func (m *MsgActivate) String() string { return proto.CompactTextString(m) }
+func (*MsgActivate) ProtoMessage()    {}
+func (*MsgActivate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{5}
+}
+func (m *MsgActivate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgActivate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgActivate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgActivate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgActivate.Merge(m, src)
+}
+func (m *MsgActivate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgActivate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgActivate.DiscardUnknown(m)
 }
 
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgActivate proto.InternalMessageInfo
+
+func (m *MsgActivate) GetValKey() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgInactivate takes an active validator out of the validator set until it
+// is activated again.
+type MsgInactivate struct {
+	ValKey github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=val_key,json=valKey,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"val_key,omitempty" yaml:"val_key"`
 }
 
-func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x3a
+func (m *MsgInactivate) Reset()         { *m = MsgInactivate{} }
+func (m *MsgInactivate) String() string { return proto.CompactTextString(m) }
+func (*MsgInactivate) ProtoMessage()    {}
+func (*MsgInactivate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{6}
+}
+func (m *MsgInactivate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInactivate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInactivate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ValKey) > 0 {
-		i -= len(m.ValKey)
-		copy(dAtA[i:], m.ValKey)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValKey)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *MsgInactivate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInactivate.Merge(m, src)
+}
+func (m *MsgInactivate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInactivate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInactivate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInactivate proto.InternalMessageInfo
+
+func (m *MsgInactivate) GetValKey() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValKey
 	}
-	{
-		size := m.Commission.Size()
-		i -= size
-		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return nil
+}
+
+// MsgExitValidator takes a validator out of the validator set and removes it
+// once the unbonding time is over.
+type MsgExitValidator struct {
+	ValKey github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=val_key,json=valKey,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"val_key,omitempty" yaml:"val_key"`
+}
+
+func (m *MsgExitValidator) Reset()         { *m = MsgExitValidator{} }
+func (m *MsgExitValidator) String() string { return proto.CompactTextString(m) }
+func (*MsgExitValidator) ProtoMessage()    {}
+func (*MsgExitValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{7}
+}
+func (m *MsgExitValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExitValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExitValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *MsgExitValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExitValidator.Merge(m, src)
+}
+func (m *MsgExitValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExitValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExitValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExitValidator proto.InternalMessageInfo
+
+func (m *MsgExitValidator) GetValKey() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValKey
 	}
-	if len(m.Social) > 0 {
-		i -= len(m.Social)
-		copy(dAtA[i:], m.Social)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Social)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+// LastValidatorPower is the power of a validator last applied to Tendermint.
+type LastValidatorPower struct {
+	ValKey github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=val_key,json=valKey,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"val_key,omitempty" yaml:"val_key"`
+	PubKey string                                        `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty" yaml:"consensus_pubkey"`
+	Power  int64                                         `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *LastValidatorPower) Reset()         { *m = LastValidatorPower{} }
+func (m *LastValidatorPower) String() string { return proto.CompactTextString(m) }
+func (*LastValidatorPower) ProtoMessage()    {}
+func (*LastValidatorPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{8}
+}
+func (m *LastValidatorPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastValidatorPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastValidatorPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *LastValidatorPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastValidatorPower.Merge(m, src)
+}
+func (m *LastValidatorPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastValidatorPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastValidatorPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastValidatorPower proto.InternalMessageInfo
+
+func (m *LastValidatorPower) GetValKey() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValKey
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *LastValidatorPower) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *LastValidatorPower) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// ValidatorSigningInfo tracks the blocks an active validator missed within the
+// sliding window of the last signed_blocks_window blocks.
+type ValidatorSigningInfo struct {
+	// start_height is the height the validator started to be tracked at.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// index_offset counts the blocks tracked, its remainder by the window size
+	// is the position of the next block in the missed blocks bitmap.
+	IndexOffset         int64 `protobuf:"varint,2,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty" yaml:"index_offset"`
+	MissedBlocksCounter int64 `protobuf:"varint,3,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty" yaml:"missed_blocks_counter"`
+}
+
+func (m *ValidatorSigningInfo) Reset()         { *m = ValidatorSigningInfo{} }
+func (m *ValidatorSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSigningInfo) ProtoMessage()    {}
+func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{9}
+}
+func (m *ValidatorSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSigningInfo.Merge(m, src)
+}
+func (m *ValidatorSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSigningInfo proto.InternalMessageInfo
+
+func (m *ValidatorSigningInfo) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetIndexOffset() int64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+// Params defines the parameters of the custom staking module.
+type Params struct {
+	// signed_blocks_window is the number of blocks downtime is measured over.
+	SignedBlocksWindow int64 `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
+	// min_signed_per_window is the part of the window a validator must sign not
+	// to be jailed.
+	MinSignedPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signed_per_window" yaml:"min_signed_per_window"`
+	// downtime_jail_duration is the number of seconds a validator jailed for
+	// downtime stays out of the validator set.
+	DowntimeJailDuration int64 `protobuf:"varint,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3" json:"downtime_jail_duration,omitempty" yaml:"downtime_jail_duration"`
+	// whitelist_enabled restricts validator claims to whitelisted ValKeys.
+	WhitelistEnabled bool `protobuf:"varint,4,opt,name=whitelist_enabled,json=whitelistEnabled,proto3" json:"whitelist_enabled,omitempty" yaml:"whitelist_enabled"`
+	// max_commission_change_rate is the most a validator can change its
+	// commission by at once.
+	MaxCommissionChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission_change_rate" yaml:"max_commission_change_rate"`
+	// commission_change_period is the number of seconds a validator must wait
+	// between two commission changes.
+	CommissionChangePeriod int64 `protobuf:"varint,6,opt,name=commission_change_period,json=commissionChangePeriod,proto3" json:"commission_change_period,omitempty" yaml:"commission_change_period"`
+	// unbonding_time is the number of seconds an exiting validator stays
+	// accountable for its infractions before it is removed.
+	UnbondingTime int64 `protobuf:"varint,7,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty" yaml:"unbonding_time"`
+	// max_validators is the size of the Tendermint validator set. The active
+	// validators ranked below wait for a seat to free up.
+	MaxValidators int64 `protobuf:"varint,8,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty" yaml:"max_validators"`
+	// max_field_length is the most bytes the moniker, website, social and
+	// identity of a validator can take, at most 64.
+	MaxFieldLength int64                                  `protobuf:"varint,9,opt,name=max_field_length,json=maxFieldLength,proto3" json:"max_field_length,omitempty" yaml:"max_field_length"`
+	MinCommission  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=min_commission,json=minCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission" yaml:"min_commission"`
+	MaxCommission  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=max_commission,json=maxCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission" yaml:"max_commission"`
+	// default_validator_power is the voting power of a newly claimed validator.
+	DefaultValidatorPower int64 `protobuf:"varint,12,opt,name=default_validator_power,json=defaultValidatorPower,proto3" json:"default_validator_power,omitempty" yaml:"default_validator_power"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{10}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeJailDuration() int64 {
+	if m != nil {
+		return m.DowntimeJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetWhitelistEnabled() bool {
+	if m != nil {
+		return m.WhitelistEnabled
+	}
+	return false
+}
+
+func (m *Params) GetCommissionChangePeriod() int64 {
+	if m != nil {
+		return m.CommissionChangePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetUnbondingTime() int64 {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
+}
+
+func (m *Params) GetMaxValidators() int64 {
+	if m != nil {
+		return m.MaxValidators
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFieldLength() int64 {
+	if m != nil {
+		return m.MaxFieldLength
+	}
+	return 0
+}
+
+func (m *Params) GetDefaultValidatorPower() int64 {
+	if m != nil {
+		return m.DefaultValidatorPower
+	}
+	return 0
+}
+
+// Infraction records a double sign committed by a validator.
+type Infraction struct {
+	ValKey  github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=val_key,json=valKey,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"val_key,omitempty" yaml:"val_key"`
+	Moniker string                                        `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	PubKey  string                                        `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty" yaml:"consensus_pubkey"`
+	// height is the height the double sign was committed at.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// power is the power the validator had in the Tendermint validator set at
+	// that height.
+	Power int64 `protobuf:"varint,5,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *Infraction) Reset()         { *m = Infraction{} }
+func (m *Infraction) String() string { return proto.CompactTextString(m) }
+func (*Infraction) ProtoMessage()    {}
+func (*Infraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{11}
+}
+func (m *Infraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Infraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Infraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Infraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Infraction.Merge(m, src)
+}
+func (m *Infraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Infraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Infraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Infraction proto.InternalMessageInfo
+
+func (m *Infraction) GetValKey() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValKey
+	}
+	return nil
+}
+
+func (m *Infraction) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *Infraction) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *Infraction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Infraction) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("kira.staking.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
+	proto.RegisterType((*MsgClaimValidator)(nil), "kira.staking.MsgClaimValidator")
+	proto.RegisterType((*Validator)(nil), "kira.staking.Validator")
+	proto.RegisterType((*MsgEditValidator)(nil), "kira.staking.MsgEditValidator")
+	proto.RegisterType((*MsgPause)(nil), "kira.staking.MsgPause")
+	proto.RegisterType((*MsgUnpause)(nil), "kira.staking.MsgUnpause")
+	proto.RegisterType((*MsgActivate)(nil), "kira.staking.MsgActivate")
+	proto.RegisterType((*MsgInactivate)(nil), "kira.staking.MsgInactivate")
+	proto.RegisterType((*MsgExitValidator)(nil), "kira.staking.MsgExitValidator")
+	proto.RegisterType((*LastValidatorPower)(nil), "kira.staking.LastValidatorPower")
+	proto.RegisterType((*ValidatorSigningInfo)(nil), "kira.staking.ValidatorSigningInfo")
+	proto.RegisterType((*Params)(nil), "kira.staking.Params")
+	proto.RegisterType((*Infraction)(nil), "kira.staking.Infraction")
+}
+
+func init() { proto.RegisterFile("staking.proto", fileDescriptor_289e7c8aea278311) }
+
+var fileDescriptor_289e7c8aea278311 = []byte{
+	// 1273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xda, 0x89, 0x93, 0x8c, 0x93, 0xd4, 0xd9, 0xe6, 0xc7, 0x7e, 0xdd, 0xd6, 0xeb, 0xce,
+	0x57, 0x42, 0x11, 0xa2, 0x89, 0x04, 0x9c, 0x72, 0x81, 0xd8, 0x71, 0x5b, 0xb7, 0x4d, 0x09, 0x9b,
+	0xa4, 0x45, 0x15, 0x68, 0x35, 0xf6, 0x4e, 0xd6, 0x53, 0xef, 0xce, 0x5a, 0x3b, 0xe3, 0xc4, 0x39,
+	0xc2, 0x09, 0xf5, 0xd4, 0x0b, 0x12, 0x97, 0x4a, 0x95, 0xfa, 0x57, 0x70, 0xe1, 0xdc, 0x63, 0x8f,
+	0x08, 0x89, 0x15, 0x6a, 0x39, 0xf4, 0xbc, 0x47, 0x4e, 0x68, 0x66, 0xd6, 0xf6, 0xc6, 0x0d, 0xa8,
+	0x2d, 0xa4, 0x12, 0x12, 0x27, 0xfb, 0xbd, 0xcf, 0xfb, 0x31, 0xf3, 0x66, 0xe6, 0x7d, 0xde, 0x82,
+	0x39, 0xc6, 0x51, 0x87, 0x50, 0x77, 0xad, 0x1b, 0x06, 0x3c, 0xd0, 0x67, 0x3b, 0x24, 0x44, 0x6b,
+	0x89, 0xae, 0xb4, 0xe8, 0x06, 0x6e, 0x20, 0x81, 0x75, 0xf1, 0x4f, 0xd9, 0xc0, 0x5f, 0xb2, 0x60,
+	0x61, 0x9b, 0xb9, 0x35, 0x0f, 0x11, 0xff, 0x0e, 0xf2, 0x88, 0x83, 0x78, 0x10, 0xea, 0x06, 0x98,
+	0xf2, 0x03, 0x4a, 0x3a, 0x38, 0x34, 0xb4, 0x8a, 0xb6, 0x3a, 0x63, 0x0d, 0x44, 0x81, 0x1c, 0xe1,
+	0x26, 0x23, 0x1c, 0x1b, 0x59, 0x85, 0x24, 0xa2, 0xbe, 0x0c, 0xf2, 0x2c, 0x68, 0x11, 0xe4, 0x19,
+	0x39, 0x09, 0x24, 0x92, 0x5e, 0x02, 0xd3, 0xc4, 0xc1, 0x94, 0x13, 0x7e, 0x6c, 0x4c, 0x48, 0x64,
+	0x28, 0xeb, 0x2d, 0x00, 0x5a, 0x81, 0xef, 0x13, 0xc6, 0x48, 0x40, 0x8d, 0x49, 0x81, 0x56, 0x6b,
+	0x4f, 0x23, 0x33, 0xf3, 0x73, 0x64, 0xbe, 0xe7, 0x12, 0xde, 0xee, 0x35, 0xd7, 0x5a, 0x81, 0xbf,
+	0xde, 0x0a, 0x98, 0x1f, 0xb0, 0xe4, 0xe7, 0x0a, 0x73, 0x3a, 0xeb, 0xfc, 0xb8, 0x8b, 0xd9, 0xda,
+	0x16, 0x6e, 0xc5, 0x91, 0xb9, 0x70, 0x8c, 0x7c, 0x6f, 0x03, 0x8e, 0x22, 0x41, 0x2b, 0x15, 0x56,
+	0xff, 0x12, 0x4c, 0x1d, 0x22, 0xcf, 0xee, 0xe0, 0x63, 0x23, 0x5f, 0xd1, 0x56, 0x67, 0xab, 0xb5,
+	0x38, 0x32, 0xe7, 0x95, 0x4f, 0x02, 0xc0, 0xdf, 0x23, 0xf3, 0xca, 0x6b, 0xe4, 0xbb, 0x83, 0xbc,
+	0x4d, 0xc7, 0x09, 0x31, 0x63, 0x56, 0xfe, 0x10, 0x79, 0x37, 0xf1, 0xb1, 0xbe, 0x02, 0xa6, 0xba,
+	0xbd, 0xa6, 0x8c, 0x3e, 0xa5, 0xf6, 0xdd, 0xed, 0x35, 0x6f, 0xe2, 0xe3, 0x8d, 0x89, 0x97, 0x8f,
+	0x4d, 0x0d, 0x3e, 0x99, 0x04, 0x33, 0xff, 0xd5, 0xf5, 0x2d, 0xea, 0xfa, 0xf1, 0x58, 0x5d, 0xab,
+	0x17, 0xe2, 0xc8, 0x5c, 0x19, 0xac, 0x88, 0x32, 0x4c, 0x59, 0x8f, 0xd9, 0xdd, 0x5e, 0x53, 0xa4,
+	0x19, 0x14, 0x5d, 0x5f, 0x04, 0x93, 0xdd, 0xe0, 0x08, 0x87, 0xc6, 0x74, 0x45, 0x5b, 0xcd, 0x59,
+	0x4a, 0xd0, 0xaf, 0x83, 0x3c, 0xe3, 0x88, 0xf7, 0x98, 0x31, 0x53, 0xd1, 0x56, 0xe7, 0x3f, 0xbc,
+	0xb4, 0x96, 0x7e, 0x19, 0x6b, 0xc3, 0xf3, 0xd9, 0x95, 0x46, 0xd5, 0x85, 0x38, 0x32, 0xe7, 0x54,
+	0x26, 0xe5, 0x06, 0xad, 0xc4, 0x5f, 0xdf, 0x00, 0xb3, 0xf7, 0x11, 0xf1, 0xb0, 0x63, 0xf7, 0x28,
+	0x27, 0x9e, 0x01, 0x44, 0x9a, 0xea, 0x4a, 0x1c, 0x99, 0xe7, 0x95, 0x43, 0x1a, 0x85, 0x56, 0x41,
+	0x89, 0xfb, 0x42, 0xd2, 0xf7, 0xc0, 0xd2, 0xa8, 0x7a, 0x76, 0xaf, 0xeb, 0x20, 0x8e, 0x1d, 0x1b,
+	0x71, 0xa3, 0x20, 0x83, 0x54, 0xe2, 0xc8, 0xbc, 0x38, 0x5e, 0xf1, 0x94, 0x19, 0xb4, 0xce, 0x8f,
+	0xf4, 0xfb, 0x4a, 0xbd, 0xc9, 0xf5, 0x1a, 0x38, 0xd7, 0xa3, 0xcd, 0x80, 0x3a, 0x84, 0xba, 0xc9,
+	0xa2, 0x66, 0x65, 0xbc, 0x52, 0x1c, 0x99, 0xcb, 0x2a, 0xde, 0x98, 0x01, 0xb4, 0xe6, 0x87, 0x1a,
+	0xb9, 0x34, 0xf8, 0x43, 0x16, 0x14, 0xb7, 0x99, 0x5b, 0x77, 0x08, 0x7f, 0xf7, 0x97, 0xd5, 0x3e,
+	0xe5, 0xb2, 0x7e, 0xf2, 0xaf, 0xb9, 0xa8, 0xb0, 0x0d, 0xa6, 0xb7, 0x99, 0xbb, 0x83, 0x7a, 0x0c,
+	0xa7, 0x33, 0x69, 0xff, 0x7c, 0xa6, 0xfb, 0x00, 0x6c, 0x33, 0x77, 0x9f, 0x76, 0xdf, 0x41, 0xae,
+	0x0e, 0x28, 0x6c, 0x33, 0x77, 0xb3, 0xc5, 0xc9, 0x21, 0xe2, 0x67, 0x9d, 0xcc, 0x07, 0x73, 0xdb,
+	0xcc, 0x6d, 0x50, 0xf4, 0x6e, 0xd2, 0x75, 0xd5, 0x65, 0xef, 0xa7, 0x2f, 0xfb, 0xd9, 0x66, 0xfc,
+	0x51, 0x03, 0xfa, 0x2d, 0xc4, 0x46, 0xf9, 0x76, 0x64, 0x5f, 0x3a, 0xd3, 0xa4, 0xe9, 0x0e, 0x9a,
+	0x7d, 0x8b, 0x0e, 0x9a, 0x4b, 0x75, 0x50, 0xf8, 0x9b, 0x06, 0x16, 0x47, 0x6d, 0x92, 0xb8, 0x94,
+	0x50, 0xb7, 0x41, 0x0f, 0x02, 0xd1, 0x10, 0x19, 0x47, 0x21, 0xb7, 0xdb, 0x98, 0xb8, 0x6d, 0x6e,
+	0x68, 0xe3, 0x0d, 0x31, 0x8d, 0x42, 0xab, 0x20, 0xc5, 0xeb, 0x52, 0x12, 0xbe, 0x84, 0x3a, 0xb8,
+	0x6f, 0x07, 0x07, 0x07, 0x0c, 0x73, 0x23, 0x3b, 0xee, 0x9b, 0x46, 0xa1, 0x55, 0x90, 0xe2, 0x67,
+	0x52, 0x12, 0xcd, 0x54, 0x3c, 0x6f, 0xec, 0xd8, 0x4d, 0x2f, 0x68, 0x75, 0x98, 0xdd, 0x0a, 0x7a,
+	0x94, 0x0f, 0x96, 0x9d, 0x6e, 0xa6, 0xa7, 0x9a, 0x41, 0xeb, 0xbc, 0xd2, 0x57, 0xa5, 0xba, 0x96,
+	0x68, 0xbf, 0x99, 0x01, 0xf9, 0x1d, 0x14, 0x22, 0x9f, 0xe9, 0x9f, 0x83, 0x45, 0x46, 0x5c, 0x3a,
+	0xf2, 0x3c, 0x22, 0xd4, 0x09, 0x8e, 0x92, 0x0d, 0x9a, 0x71, 0x64, 0x5e, 0x48, 0x36, 0x78, 0x8a,
+	0x15, 0xb4, 0x74, 0xa5, 0x56, 0xe1, 0xef, 0x4a, 0xa5, 0xfe, 0xb5, 0x26, 0x16, 0x4d, 0xed, 0xc4,
+	0xa3, 0x8b, 0xc3, 0x41, 0x50, 0x75, 0x3e, 0xb7, 0xdf, 0x98, 0xa1, 0x87, 0x5b, 0x3c, 0x25, 0x28,
+	0xb4, 0x74, 0x9f, 0xd0, 0x5d, 0xa9, 0xde, 0xc1, 0x61, 0xb2, 0x86, 0xbb, 0x60, 0xd9, 0x09, 0x8e,
+	0x28, 0x27, 0x3e, 0xb6, 0x05, 0x39, 0xd9, 0x4e, 0x2f, 0x44, 0x5c, 0x34, 0x5e, 0x55, 0xb8, 0xcb,
+	0x71, 0x64, 0x5e, 0x52, 0x51, 0x4f, 0xb7, 0x83, 0xd6, 0xe2, 0x00, 0xb8, 0x81, 0x88, 0xb7, 0x95,
+	0xa8, 0xf5, 0x06, 0x58, 0x38, 0x6a, 0x13, 0x8e, 0x3d, 0xc2, 0xb8, 0x8d, 0x29, 0x6a, 0x7a, 0xd8,
+	0x91, 0xad, 0x7e, 0xba, 0x7a, 0x31, 0x8e, 0x4c, 0x43, 0xc5, 0x7c, 0xc5, 0x04, 0x5a, 0xc5, 0xa1,
+	0xae, 0xae, 0x54, 0xfa, 0x43, 0x0d, 0x94, 0x7c, 0xd4, 0xb7, 0x53, 0x34, 0xd8, 0x6a, 0x23, 0xea,
+	0x62, 0x3b, 0x44, 0x1c, 0x27, 0x0c, 0xb1, 0xfb, 0xc6, 0xc5, 0xba, 0x9c, 0x14, 0xeb, 0x4f, 0x23,
+	0x43, 0x6b, 0xc5, 0x47, 0xfd, 0xda, 0x10, 0xab, 0x49, 0xc8, 0x12, 0x0d, 0xe9, 0x2b, 0x60, 0xbc,
+	0xea, 0xd3, 0xc5, 0x21, 0x09, 0x1c, 0xc9, 0x29, 0xb9, 0xea, 0xff, 0xe3, 0xc8, 0x34, 0x5f, 0xa1,
+	0xef, 0x13, 0x96, 0xd0, 0x5a, 0x6e, 0x8d, 0x05, 0xdf, 0x91, 0x80, 0xfe, 0x29, 0x18, 0x31, 0xb2,
+	0x2d, 0x4a, 0x2b, 0x67, 0x9e, 0x5c, 0xf5, 0x7f, 0x71, 0x64, 0x2e, 0x8d, 0x73, 0xb8, 0xc0, 0xa1,
+	0x35, 0x37, 0x54, 0xec, 0x11, 0x1f, 0x8b, 0x08, 0x62, 0x63, 0x87, 0x83, 0x37, 0xca, 0x8c, 0xe9,
+	0xf1, 0x08, 0x27, 0x71, 0x68, 0xcd, 0xf9, 0xa8, 0x3f, 0x7c, 0xd3, 0x4c, 0xaf, 0x83, 0xa2, 0xb0,
+	0x38, 0x20, 0xd8, 0x73, 0x6c, 0x0f, 0x53, 0x97, 0xb7, 0xe5, 0xb8, 0x94, 0x4b, 0xf7, 0x8d, 0x71,
+	0x0b, 0x68, 0x89, 0xb4, 0x57, 0x85, 0xe6, 0x96, 0x54, 0xe8, 0x14, 0xcc, 0x8b, 0xeb, 0x98, 0x62,
+	0x74, 0x20, 0xcf, 0xeb, 0xda, 0x1b, 0x9f, 0xd7, 0xd2, 0xe8, 0x72, 0xa7, 0x99, 0x7d, 0xce, 0x27,
+	0x74, 0x74, 0x46, 0x32, 0xdf, 0x89, 0x13, 0x35, 0x0a, 0x7f, 0x33, 0x1f, 0xea, 0x8f, 0xe5, 0x4b,
+	0xdf, 0x09, 0xfd, 0x1e, 0x58, 0x71, 0xf0, 0x01, 0xea, 0x79, 0x7c, 0x54, 0x4c, 0x5b, 0x75, 0x4c,
+	0x35, 0x77, 0xc1, 0x38, 0x32, 0xcb, 0xc9, 0x0b, 0x3a, 0xdd, 0x10, 0x5a, 0x4b, 0x09, 0x72, 0x92,
+	0x0f, 0x36, 0x26, 0xbe, 0x7f, 0x6c, 0x66, 0xe0, 0x4b, 0x0d, 0x80, 0x06, 0x3d, 0x08, 0x05, 0x1b,
+	0x9e, 0x9c, 0x5e, 0xce, 0x80, 0x24, 0x52, 0x43, 0x5e, 0xf6, 0xe4, 0x90, 0x97, 0xa2, 0x8f, 0xdc,
+	0xeb, 0xd3, 0xc7, 0x32, 0xc8, 0x27, 0x4c, 0x30, 0x21, 0xf9, 0x23, 0x91, 0x46, 0xb4, 0x32, 0x99,
+	0xa2, 0x95, 0xf7, 0xbf, 0xd3, 0xc0, 0xb9, 0xb1, 0xe9, 0x5b, 0x44, 0xd8, 0xac, 0xed, 0x35, 0xee,
+	0xd4, 0x8b, 0x99, 0x12, 0x78, 0xf0, 0xa8, 0x92, 0x97, 0x43, 0x08, 0x16, 0x23, 0x64, 0xe3, 0x76,
+	0x82, 0x68, 0xa5, 0xd9, 0x07, 0x8f, 0x2a, 0xd3, 0xc9, 0xc4, 0x20, 0xc7, 0xce, 0x9d, 0xcd, 0xfd,
+	0xdd, 0xfa, 0x56, 0x31, 0xab, 0x7c, 0xe4, 0x38, 0xe6, 0x08, 0xfd, 0x8d, 0xcd, 0xc6, 0xad, 0xfa,
+	0x56, 0x31, 0xa7, 0xf4, 0x37, 0xe4, 0x3c, 0x2e, 0x76, 0x5d, 0xff, 0xa2, 0xb1, 0xd7, 0xb8, 0x7d,
+	0xad, 0x38, 0x51, 0x2a, 0x3c, 0x78, 0x54, 0x99, 0x12, 0xd3, 0x80, 0xf8, 0x4a, 0x9e, 0xf8, 0xf6,
+	0x49, 0x39, 0x53, 0xbd, 0xfa, 0xf4, 0x79, 0x59, 0x7b, 0xf6, 0xbc, 0xac, 0xfd, 0xfa, 0xbc, 0xac,
+	0x3d, 0x7c, 0x51, 0xce, 0x3c, 0x7b, 0x51, 0xce, 0xfc, 0xf4, 0xa2, 0x9c, 0xb9, 0xf7, 0xc1, 0x5f,
+	0x96, 0xb9, 0xbf, 0x9e, 0x7c, 0x53, 0xa8, 0x82, 0x37, 0xf3, 0xf2, 0x23, 0xfb, 0xa3, 0x3f, 0x06,
+	0x00, 0x48, 0x85, 0xda, 0xf6, 0x99, 0x0f, 0x00, 0x00,
+}
+
+func (this *MsgClaimValidator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgClaimValidator)
+	if !ok {
+		that2, ok := that.(MsgClaimValidator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Moniker != that1.Moniker {
+		return false
+	}
+	if this.Website != that1.Website {
+		return false
+	}
+	if this.Social != that1.Social {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if !this.Commission.Equal(that1.Commission) {
+		return false
+	}
+	if !bytes.Equal(this.ValKey, that1.ValKey) {
+		return false
+	}
+	if this.PubKey != that1.PubKey {
+		return false
+	}
+	return true
+}
+func (m *MsgClaimValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ValKey) > 0 {
+		i -= len(m.ValKey)
+		copy(dAtA[i:], m.ValKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Social) > 0 {
+		i -= len(m.Social)
+		copy(dAtA[i:], m.Social)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Social)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingUntil != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingUntil))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CommissionUpdatedAt != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CommissionUpdatedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.JailedUntil != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Status != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Power != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ValKey) > 0 {
+		i -= len(m.ValKey)
+		copy(dAtA[i:], m.ValKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Social) > 0 {
+		i -= len(m.Social)
+		copy(dAtA[i:], m.Social)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Social)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValKey) > 0 {
+		i -= len(m.ValKey)
+		copy(dAtA[i:], m.ValKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Social) > 0 {
+		i -= len(m.Social)
+		copy(dAtA[i:], m.Social)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Social)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValKey) > 0 {
+		i -= len(m.ValKey)
+		copy(dAtA[i:], m.ValKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValKey) > 0 {
+		i -= len(m.ValKey)
+		copy(dAtA[i:], m.ValKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValKey) > 0 {
+		i -= len(m.ValKey)
+		copy(dAtA[i:], m.ValKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInactivate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInactivate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInactivate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValKey) > 0 {
+		i -= len(m.ValKey)
+		copy(dAtA[i:], m.ValKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExitValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExitValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExitValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValKey) > 0 {
+		i -= len(m.ValKey)
+		copy(dAtA[i:], m.ValKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastValidatorPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastValidatorPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValKey) > 0 {
+		i -= len(m.ValKey)
+		copy(dAtA[i:], m.ValKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DefaultValidatorPower != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.DefaultValidatorPower))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MinCommission.Size()
+		i -= size
+		if _, err := m.MinCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MaxFieldLength != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxFieldLength))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxValidators != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CommissionChangePeriod != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CommissionChangePeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxCommissionChangeRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.WhitelistEnabled {
+		i--
+		if m.WhitelistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DowntimeJailDuration != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.DowntimeJailDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Infraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Infraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Infraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValKey) > 0 {
+		i -= len(m.ValKey)
+		copy(dAtA[i:], m.ValKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgClaimValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Social)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.ValKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Social)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.ValKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovStaking(uint64(m.Power))
+	}
+	if m.Status != 0 {
+		n += 1 + sovStaking(uint64(m.Status))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovStaking(uint64(m.JailedUntil))
+	}
+	if m.CommissionUpdatedAt != 0 {
+		n += 1 + sovStaking(uint64(m.CommissionUpdatedAt))
+	}
+	if m.UnbondingUntil != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingUntil))
+	}
+	return n
+}
+
+func (m *MsgEditValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Social)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgActivate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInactivate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExitValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *LastValidatorPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovStaking(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovStaking(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovStaking(uint64(m.IndexOffset))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovStaking(uint64(m.MissedBlocksCounter))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovStaking(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.DowntimeJailDuration != 0 {
+		n += 1 + sovStaking(uint64(m.DowntimeJailDuration))
+	}
+	if m.WhitelistEnabled {
+		n += 2
+	}
+	l = m.MaxCommissionChangeRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.CommissionChangePeriod != 0 {
+		n += 1 + sovStaking(uint64(m.CommissionChangePeriod))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingTime))
+	}
+	if m.MaxValidators != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidators))
+	}
+	if m.MaxFieldLength != 0 {
+		n += 1 + sovStaking(uint64(m.MaxFieldLength))
+	}
+	l = m.MinCommission.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.DefaultValidatorPower != 0 {
+		n += 1 + sovStaking(uint64(m.DefaultValidatorPower))
+	}
+	return n
+}
+
+func (m *Infraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	if m.Power != 0 {
+		n += 1 + sovStaking(uint64(m.Power))
+	}
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgClaimValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Social", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Social = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValKey == nil {
+				m.ValKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Social", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Social = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValKey == nil {
+				m.ValKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionUpdatedAt", wireType)
+			}
+			m.CommissionUpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommissionUpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingUntil", wireType)
+			}
+			m.UnbondingUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Social", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Social = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValKey == nil {
+				m.ValKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValKey == nil {
+				m.ValKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValKey == nil {
+				m.ValKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValKey == nil {
+				m.ValKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInactivate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInactivate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInactivate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValKey == nil {
+				m.ValKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExitValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExitValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExitValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValKey == nil {
+				m.ValKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastValidatorPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastValidatorPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastValidatorPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValKey == nil {
+				m.ValKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgClaimValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Social)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = m.Commission.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = len(m.ValKey)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Social)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = m.Commission.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = len(m.ValKey)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgClaimValidator) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -532,17 +3610,70 @@ func (m *MsgClaimValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
 			}
-			var stringLen uint64
+			m.DowntimeJailDuration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -552,27 +3683,34 @@ func (m *MsgClaimValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DowntimeJailDuration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistEnabled", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.WhitelistEnabled = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -600,13 +3738,15 @@ func (m *MsgClaimValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Social", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangePeriod", wireType)
 			}
-			var stringLen uint64
+			m.CommissionChangePeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -616,29 +3756,35 @@ func (m *MsgClaimValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CommissionChangePeriod |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Social = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
 			}
-			var stringLen uint64
+			m.MaxValidators = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -648,27 +3794,33 @@ func (m *MsgClaimValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxValidators |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFieldLength", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaxFieldLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFieldLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -696,15 +3848,15 @@ func (m *MsgClaimValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -714,31 +3866,31 @@ func (m *MsgClaimValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValKey == nil {
-				m.ValKey = []byte{}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValidatorPower", wireType)
 			}
-			var stringLen uint64
+			m.DefaultValidatorPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -748,24 +3900,11 @@ func (m *MsgClaimValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DefaultValidatorPower |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -790,7 +3929,7 @@ func (m *MsgClaimValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *Infraction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -813,17 +3952,17 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: Infraction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Infraction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -833,27 +3972,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValKey == nil {
+				m.ValKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -881,11 +4022,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Social", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -913,13 +4054,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Social = string(dAtA[iNdEx:postIndex])
+			m.PubKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -929,97 +4070,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValKey == nil {
-				m.ValKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
-			}
-			var stringLen uint64
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -1029,24 +4089,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
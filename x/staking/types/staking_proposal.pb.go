@@ -0,0 +1,732 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: staking_proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SetValidatorPowerProposal sets the voting power of a validator.
+type SetValidatorPowerProposal struct {
+	Title       string                                        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ValKey      github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,3,opt,name=val_key,json=valKey,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"val_key,omitempty" yaml:"val_key"`
+	Power       int64                                         `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *SetValidatorPowerProposal) Reset()      { *m = SetValidatorPowerProposal{} }
+func (*SetValidatorPowerProposal) ProtoMessage() {}
+func (*SetValidatorPowerProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_195f31ffdc3a02e1, []int{0}
+}
+func (m *SetValidatorPowerProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetValidatorPowerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetValidatorPowerProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetValidatorPowerProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetValidatorPowerProposal.Merge(m, src)
+}
+func (m *SetValidatorPowerProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetValidatorPowerProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetValidatorPowerProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetValidatorPowerProposal proto.InternalMessageInfo
+
+// SetValidatorWhitelistProposal adds a ValKey to or removes it from the
+// whitelist of addresses allowed to claim a validator seat.
+type SetValidatorWhitelistProposal struct {
+	Title       string                                        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ValKey      github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,3,opt,name=val_key,json=valKey,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"val_key,omitempty" yaml:"val_key"`
+	Whitelisted bool                                          `protobuf:"varint,4,opt,name=whitelisted,proto3" json:"whitelisted,omitempty"`
+}
+
+func (m *SetValidatorWhitelistProposal) Reset()      { *m = SetValidatorWhitelistProposal{} }
+func (*SetValidatorWhitelistProposal) ProtoMessage() {}
+func (*SetValidatorWhitelistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_195f31ffdc3a02e1, []int{1}
+}
+func (m *SetValidatorWhitelistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetValidatorWhitelistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetValidatorWhitelistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetValidatorWhitelistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetValidatorWhitelistProposal.Merge(m, src)
+}
+func (m *SetValidatorWhitelistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetValidatorWhitelistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetValidatorWhitelistProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetValidatorWhitelistProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SetValidatorPowerProposal)(nil), "kira.staking.SetValidatorPowerProposal")
+	proto.RegisterType((*SetValidatorWhitelistProposal)(nil), "kira.staking.SetValidatorWhitelistProposal")
+}
+
+func init() { proto.RegisterFile("staking_proposal.proto", fileDescriptor_195f31ffdc3a02e1) }
+
+var fileDescriptor_195f31ffdc3a02e1 = []byte{
+	// 324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0xb1, 0x4b, 0xfb, 0x40,
+	0x14, 0xc7, 0x73, 0xbf, 0xfe, 0xac, 0xf5, 0x5a, 0x1c, 0x42, 0x91, 0x28, 0x98, 0x84, 0x4c, 0x1d,
+	0x6c, 0x32, 0xb8, 0x75, 0xb3, 0x82, 0x8b, 0x4b, 0x89, 0x50, 0x41, 0x84, 0x72, 0x6d, 0x8e, 0xf4,
+	0xc8, 0xb5, 0xef, 0xb8, 0x3b, 0x5b, 0xf3, 0x1f, 0x38, 0x3a, 0x3a, 0xf6, 0xcf, 0x71, 0xac, 0x9b,
+	0x53, 0xd1, 0xf6, 0x3f, 0x70, 0x74, 0x92, 0x26, 0x11, 0x33, 0x39, 0x3b, 0xdd, 0xbd, 0xf7, 0x3d,
+	0xee, 0x7d, 0x3e, 0xf0, 0xf0, 0x81, 0xd2, 0x24, 0x61, 0xd3, 0x78, 0x20, 0x24, 0x08, 0x50, 0x84,
+	0xfb, 0x42, 0x82, 0x06, 0xb3, 0x91, 0x30, 0x49, 0xfc, 0x22, 0x3c, 0x6a, 0xc6, 0x10, 0x43, 0x16,
+	0x04, 0xdb, 0x5b, 0xfe, 0xc6, 0x7b, 0x41, 0xf8, 0xf0, 0x8a, 0xea, 0x3e, 0xe1, 0x2c, 0x22, 0x1a,
+	0x64, 0x0f, 0xe6, 0x54, 0xf6, 0x8a, 0x7f, 0xcc, 0x26, 0xde, 0xd1, 0x4c, 0x73, 0x6a, 0x21, 0x17,
+	0xb5, 0xf6, 0xc2, 0xbc, 0x30, 0x5d, 0x5c, 0x8f, 0xa8, 0x1a, 0x49, 0x26, 0x34, 0x83, 0xa9, 0xf5,
+	0x2f, 0xcb, 0xca, 0x2d, 0xf3, 0x16, 0xef, 0xce, 0x08, 0x1f, 0x24, 0x34, 0xb5, 0x2a, 0x2e, 0x6a,
+	0x35, 0xba, 0xe7, 0x1f, 0x2b, 0x67, 0x3f, 0x25, 0x13, 0xde, 0xf1, 0x8a, 0xc0, 0xfb, 0x5c, 0x39,
+	0xed, 0x98, 0xe9, 0xf1, 0xdd, 0xd0, 0x1f, 0xc1, 0x24, 0x18, 0x81, 0x9a, 0x80, 0x2a, 0x8e, 0xb6,
+	0x8a, 0x92, 0x40, 0xa7, 0x82, 0x2a, 0xbf, 0x4f, 0xf8, 0x59, 0x14, 0x49, 0xaa, 0x54, 0x58, 0x9d,
+	0x11, 0x7e, 0x49, 0xd3, 0x2d, 0x95, 0xd8, 0x62, 0x5a, 0xff, 0x5d, 0xd4, 0xaa, 0x84, 0x79, 0xd1,
+	0xa9, 0x3d, 0x2c, 0x1c, 0xe3, 0x69, 0xe1, 0x18, 0xde, 0x3b, 0xc2, 0xc7, 0x65, 0xa7, 0xeb, 0x31,
+	0xd3, 0x94, 0x33, 0xa5, 0xff, 0xb8, 0x97, 0x8b, 0xeb, 0xf3, 0x6f, 0x54, 0x1a, 0x65, 0x76, 0xb5,
+	0xb0, 0xdc, 0xfa, 0x71, 0xec, 0x5e, 0x3c, 0xaf, 0x6d, 0xb4, 0x5c, 0xdb, 0xe8, 0x6d, 0x6d, 0xa3,
+	0xc7, 0x8d, 0x6d, 0x2c, 0x37, 0xb6, 0xf1, 0xba, 0xb1, 0x8d, 0x9b, 0x93, 0x5f, 0x87, 0xdf, 0x07,
+	0xc5, 0x3e, 0xe4, 0x18, 0xc3, 0x6a, 0xb6, 0x06, 0xa7, 0x5f, 0x03, 0x00, 0x93, 0x57, 0x2f, 0xdd,
+	0x44, 0x02, 0x00, 0x00,
+}
+
+func (m *SetValidatorPowerProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetValidatorPowerProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetValidatorPowerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintStakingProposal(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValKey) > 0 {
+		i -= len(m.ValKey)
+		copy(dAtA[i:], m.ValKey)
+		i = encodeVarintStakingProposal(dAtA, i, uint64(len(m.ValKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintStakingProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintStakingProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetValidatorWhitelistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetValidatorWhitelistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetValidatorWhitelistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Whitelisted {
+		i--
+		if m.Whitelisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValKey) > 0 {
+		i -= len(m.ValKey)
+		copy(dAtA[i:], m.ValKey)
+		i = encodeVarintStakingProposal(dAtA, i, uint64(len(m.ValKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintStakingProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintStakingProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStakingProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStakingProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SetValidatorPowerProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovStakingProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovStakingProposal(uint64(l))
+	}
+	l = len(m.ValKey)
+	if l > 0 {
+		n += 1 + l + sovStakingProposal(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovStakingProposal(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *SetValidatorWhitelistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovStakingProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovStakingProposal(uint64(l))
+	}
+	l = len(m.ValKey)
+	if l > 0 {
+		n += 1 + l + sovStakingProposal(uint64(l))
+	}
+	if m.Whitelisted {
+		n += 2
+	}
+	return n
+}
+
+func sovStakingProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStakingProposal(x uint64) (n int) {
+	return sovStakingProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SetValidatorPowerProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakingProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetValidatorPowerProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetValidatorPowerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakingProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakingProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakingProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValKey == nil {
+				m.ValKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakingProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakingProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetValidatorWhitelistProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakingProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetValidatorWhitelistProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetValidatorWhitelistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakingProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakingProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakingProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValKey = append(m.ValKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValKey == nil {
+				m.ValKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakingProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Whitelisted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakingProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStakingProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStakingProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStakingProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStakingProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStakingProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStakingProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStakingProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStakingProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStakingProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStakingProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStakingProposal = fmt.Errorf("proto: unexpected end of group")
+)
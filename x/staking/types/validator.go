@@ -3,9 +3,25 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
-// NewValidator generates new Validator.
+const (
+	// DefaultValidatorPower is the voting power of a newly claimed validator.
+	DefaultValidatorPower int64 = 1
+
+	// MaxFieldLength is the most bytes the moniker, website, social and
+	// identity of a validator can ever take. The max field length param can
+	// only be tighter.
+	MaxFieldLength int64 = 64
+
+	// MaxValidatorPower is the most voting power the validators can have,
+	// alone or all together. Tendermint rejects validator sets with more total
+	// power.
+	MaxValidatorPower = tmtypes.MaxTotalVotingPower
+)
+
+// NewValidator generates new Validator with the default voting power.
 func NewValidator(moniker string, website string, social string,
 	identity string, comission sdk.Dec, valKey sdk.ValAddress, pubKey crypto.PubKey) (Validator, error) {
 	var pkStr string
@@ -21,6 +37,7 @@ func NewValidator(moniker string, website string, social string,
 		Commission: comission,
 		ValKey:     valKey,
 		PubKey:     pkStr,
+		Power:      DefaultValidatorPower,
 	}
 
 	err := v.Validate()
@@ -33,25 +50,48 @@ func NewValidator(moniker string, website string, social string,
 
 // Validate validates if a validator is correct.
 func (v Validator) Validate() error {
-	if len(v.Moniker) > 64 {
+	if int64(len(v.Moniker)) > MaxFieldLength {
 		return ErrInvalidMonikerLength
 	}
 
-	if len(v.Website) > 64 {
+	if int64(len(v.Website)) > MaxFieldLength {
 		return ErrInvalidWebsiteLength
 	}
 
-	if len(v.Social) > 64 {
+	if int64(len(v.Social)) > MaxFieldLength {
 		return ErrInvalidSocialLength
 	}
 
-	if len(v.Identity) > 64 {
+	if int64(len(v.Identity)) > MaxFieldLength {
 		return ErrInvalidIdentityLength
 	}
 
+	if v.Power < 0 || v.Power > MaxValidatorPower {
+		return ErrInvalidValidatorPower
+	}
+
+	if _, ok := ValidatorStatus_name[int32(v.Status)]; !ok {
+		return ErrInvalidValidatorStatus
+	}
+
 	return nil
 }
 
 func (v Validator) GetConsPubKey() crypto.PubKey {
 	return sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, v.PubKey)
 }
+
+// ConsensusPower returns the power the validator has in the Tendermint
+// validator set, which is zero unless it is active.
+func (v Validator) ConsensusPower() int64 {
+	if v.Status != Active {
+		return 0
+	}
+
+	return v.Power
+}
+
+// GetConsAddr returns the consensus address of the validator.
+func (v Validator) GetConsAddr() sdk.ConsAddress {
+	return sdk.ConsAddress(v.GetConsPubKey().Address())
+}
@@ -0,0 +1,22 @@
+package staking
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/KiraCore/sekai/x/staking/keeper"
+)
+
+// ProtoStoreUpgradeName is the name of the software upgrade re-encoding the
+// custom staking store from amino to protobuf. The upgrade height is set by
+// the software upgrade proposal of that name.
+const ProtoStoreUpgradeName = "customstaking-proto-store"
+
+// NewProtoStoreUpgradeHandler returns the handler of the ProtoStoreUpgradeName
+// upgrade, migrating the values stored with the legacy amino codec.
+func NewProtoStoreUpgradeHandler(k keeper.Keeper, legacyAmino *codec.LegacyAmino) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan) {
+		k.MigrateAminoStore(ctx, legacyAmino)
+	}
+}
@@ -0,0 +1,187 @@
+package staking_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/KiraCore/sekai/simapp"
+	"github.com/KiraCore/sekai/x/dex"
+	dextypes "github.com/KiraCore/sekai/x/dex/types"
+	"github.com/KiraCore/sekai/x/staking"
+	customtypes "github.com/KiraCore/sekai/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc-transfer/types"
+	ibchost "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func TestProtoStoreUpgrade_MigratesAminoValues(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	validator, err := customtypes.NewValidator("moniker", "some-web.com", "A Social", "My Identity", types.NewDecWithPrec(1, 1), types.ValAddress(pubKey.Address()), pubKey)
+	require.NoError(t, err)
+	last := customtypes.LastValidatorPower{ValKey: validator.ValKey, PubKey: validator.PubKey, Power: 1}
+	info := customtypes.ValidatorSigningInfo{StartHeight: 3, IndexOffset: 7, MissedBlocksCounter: 2}
+	infraction := customtypes.Infraction{ValKey: validator.ValKey, Moniker: validator.Moniker, PubKey: validator.PubKey, Height: 5, Power: 1}
+
+	// Store the values as the amino keeper did before the upgrade.
+	legacyAmino := app.LegacyAmino()
+	store := ctx.KVStore(app.GetKey(customtypes.ModuleName))
+	store.Set(customtypes.GetValidatorKey(validator.ValKey), legacyAmino.MustMarshalBinaryBare(&validator))
+	store.Set(customtypes.GetValidatorByMonikerKey(validator.Moniker), customtypes.GetValidatorKey(validator.ValKey))
+	store.Set(customtypes.GetLastValidatorPowerKey(validator.ValKey), legacyAmino.MustMarshalBinaryBare(&last))
+	store.Set(customtypes.GetValidatorSigningInfoKey(validator.ValKey), legacyAmino.MustMarshalBinaryBare(&info))
+	store.Set(customtypes.GetInfractionKey(validator.ValKey, infraction.Height), legacyAmino.MustMarshalBinaryBare(&infraction))
+
+	require.True(t, app.UpgradeKeeper.HasHandler(staking.ProtoStoreUpgradeName))
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: staking.ProtoStoreUpgradeName, Height: ctx.BlockHeight()})
+
+	require.Equal(t, validator, app.CustomStakingKeeper.GetValidator(ctx, validator.ValKey))
+	require.Equal(t, validator, app.CustomStakingKeeper.GetValidatorByMoniker(ctx, validator.Moniker))
+	require.Equal(t, []customtypes.LastValidatorPower{last}, app.CustomStakingKeeper.GetLastValidatorSet(ctx))
+	migratedInfo, found := app.CustomStakingKeeper.GetValidatorSigningInfo(ctx, validator.ValKey)
+	require.True(t, found)
+	require.Equal(t, info, migratedInfo)
+	require.Equal(t, []customtypes.Infraction{infraction}, app.CustomStakingKeeper.GetInfractions(ctx, validator.ValKey))
+
+	// The migrated values are protobuf encoded.
+	bz, err := app.AppCodec().MarshalBinaryBare(&validator)
+	require.NoError(t, err)
+	require.Equal(t, bz, store.Get(customtypes.GetValidatorKey(validator.ValKey)))
+}
+
+// baselineValidator is the validator as the amino keeper stored it before
+// validators had a status, a power or commission tracking.
+type baselineValidator struct {
+	Moniker    string
+	Website    string
+	Social     string
+	Identity   string
+	Commission types.Dec
+	ValKey     types.ValAddress
+	PubKey     string
+}
+
+func TestProtoStoreUpgrade_MigratesBaselineValidators(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+
+	// The baseline store has no customstaking params.
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), append([]byte(customtypes.ModuleName), '/'))
+	iter := paramStore.Iterator(nil, nil)
+	var paramKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		paramKeys = append(paramKeys, iter.Key())
+	}
+	iter.Close()
+	require.NotEmpty(t, paramKeys)
+	for _, key := range paramKeys {
+		paramStore.Delete(key)
+	}
+
+	legacyAmino := app.LegacyAmino()
+	store := ctx.KVStore(app.GetKey(customtypes.ModuleName))
+
+	var baselineValidators []baselineValidator
+	for _, moniker := range []string{"first", "second"} {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		validator := baselineValidator{
+			Moniker:    moniker,
+			Website:    "some-web.com",
+			Social:     "A Social",
+			Identity:   "My Identity",
+			Commission: types.NewDecWithPrec(1, 1),
+			ValKey:     types.ValAddress(pubKey.Address()),
+			PubKey:     types.MustBech32ifyPubKey(types.Bech32PubKeyTypeConsPub, pubKey),
+		}
+		baselineValidators = append(baselineValidators, validator)
+
+		store.Set(customtypes.GetValidatorKey(validator.ValKey), legacyAmino.MustMarshalBinaryBare(validator))
+		store.Set(customtypes.GetValidatorByMonikerKey(validator.Moniker), customtypes.GetValidatorKey(validator.ValKey))
+	}
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: staking.ProtoStoreUpgradeName, Height: ctx.BlockHeight()})
+
+	require.Equal(t, customtypes.DefaultParams(), app.CustomStakingKeeper.GetParams(ctx))
+
+	for _, baseline := range baselineValidators {
+		validator := app.CustomStakingKeeper.GetValidator(ctx, baseline.ValKey)
+		require.Equal(t, baseline.Moniker, validator.Moniker)
+		require.Equal(t, baseline.Commission, validator.Commission)
+		require.Equal(t, baseline.PubKey, validator.PubKey)
+		require.Equal(t, customtypes.Active, validator.Status)
+		require.Equal(t, customtypes.DefaultValidatorPower, validator.Power)
+
+		// The baseline EndBlock applied every validator with power 1.
+		require.Equal(t, int64(1), app.CustomStakingKeeper.GetLastValidatorPower(ctx, baseline.ValKey))
+	}
+
+	// The migrated validators match the set Tendermint has.
+	require.Empty(t, staking.EndBlocker(ctx, app.CustomStakingKeeper))
+
+	// And leave it once they lose their power.
+	_, err := app.CustomStakingKeeper.SetValidatorPower(ctx, baselineValidators[0].ValKey, 0)
+	require.NoError(t, err)
+	valUpdates := staking.EndBlocker(ctx, app.CustomStakingKeeper)
+	require.Len(t, valUpdates, 1)
+	require.Equal(t, int64(0), valUpdates[0].Power)
+}
+
+func TestProtoStoreUpgrade_LoadsStoresAddedSinceBaseline(t *testing.T) {
+	db := dbm.NewMemDB()
+	home := t.TempDir()
+
+	// Commit the stores of the baseline app, which had no dex store.
+	baseline := store.NewCommitMultiStore(db)
+	for _, name := range []string{
+		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
+		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
+		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
+		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
+		customtypes.ModuleName,
+	} {
+		baseline.MountStoreWithDB(types.NewKVStoreKey(name), types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, baseline.LoadLatestVersion())
+	baseline.Commit()
+
+	// The baseline binary halts at the upgrade height and leaves the upgrade info on disk.
+	encodingConfig := simapp.MakeEncodingConfig()
+	haltedKeeper := upgradekeeper.NewKeeper(nil, types.NewKVStoreKey(upgradetypes.StoreKey), encodingConfig.Marshaler, home)
+	require.NoError(t, haltedKeeper.DumpUpgradeInfoToDisk(2, staking.ProtoStoreUpgradeName))
+
+	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 0, encodingConfig)
+	require.Equal(t, int64(1), app.LastBlockHeight())
+
+	ctx := app.NewUncachedContext(false, tmproto.Header{Height: 2})
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: staking.ProtoStoreUpgradeName, Height: ctx.BlockHeight()})
+
+	// The dex store starts from the default genesis and serves its module.
+	require.Equal(t, dextypes.DefaultParams().String(), app.DexKeeper.GetParams(ctx).String())
+	curator := types.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	orderBook := app.DexKeeper.CreateOrderBook(ctx, "ukex", "ubtc", "", curator)
+	require.NotPanics(t, func() { dex.EndBlocker(ctx, app.DexKeeper) })
+
+	_, found := app.DexKeeper.GetOrderBook(ctx, orderBook.ID)
+	require.True(t, found)
+}